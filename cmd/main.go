@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"my-finance-hub-api/config"
@@ -33,7 +34,13 @@ func main() {
 	}
 
 	// Criar container de dependências
-	container := container.NewContainer(database.DB)
+	container := container.NewContainer(database.DB, cfg)
+
+	// Iniciar tarefas agendadas
+	if cfg.Scheduler.Enabled {
+		container.SchedulerService.Start(context.Background())
+		defer container.SchedulerService.Stop()
+	}
 
 	// Configurar router
 	router := gin.Default()
@@ -43,7 +50,7 @@ func main() {
 		AllowOrigins:     cfg.Server.AllowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Disposition"},
 		AllowCredentials: true,
 	}
 	router.Use(cors.New(corsConfig))
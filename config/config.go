@@ -3,12 +3,16 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	Scheduler SchedulerConfig
+	Storage   StorageConfig
+	Admin     AdminConfig
 }
 
 type ServerConfig struct {
@@ -36,6 +40,24 @@ type JWTConfig struct {
 	Issuer          string
 }
 
+type SchedulerConfig struct {
+	Enabled        bool
+	RecurrenceSpec string
+	OverdueSpec    string
+}
+
+// AdminConfig lista os usuários com acesso às rotas administrativas (ex.: tarefas agendadas)
+type AdminConfig struct {
+	Emails []string
+}
+
+type StorageConfig struct {
+	Driver            string
+	LocalPath         string
+	MaxAttachmentSize int64
+	UserQuota         int64
+}
+
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
@@ -60,6 +82,20 @@ func Load() *Config {
 			RefreshHours:    getEnvAsInt("JWT_REFRESH_HOURS", 168), // 7 dias
 			Issuer:          getEnv("JWT_ISSUER", "my-finance-hub"),
 		},
+		Scheduler: SchedulerConfig{
+			Enabled:        getEnvAsBool("SCHEDULER_ENABLED", true),
+			RecurrenceSpec: getEnv("SCHEDULER_RECURRENCE_CRON", "0 3 * * *"), // diariamente às 03:00
+			OverdueSpec:    getEnv("SCHEDULER_OVERDUE_CRON", "0 8 * * *"),    // diariamente às 08:00
+		},
+		Storage: StorageConfig{
+			Driver:            getEnv("STORAGE_DRIVER", "local"),
+			LocalPath:         getEnv("STORAGE_LOCAL_PATH", "./storage"),
+			MaxAttachmentSize: int64(getEnvAsInt("ATTACHMENT_MAX_SIZE_MB", 10)) << 20,
+			UserQuota:         int64(getEnvAsInt("ATTACHMENT_USER_QUOTA_MB", 500)) << 20,
+		},
+		Admin: AdminConfig{
+			Emails: getEnvAsList("ADMIN_EMAILS"),
+		},
 	}
 }
 
@@ -70,6 +106,25 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func getEnvAsInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if intValue, err := strconv.Atoi(value); err == nil {
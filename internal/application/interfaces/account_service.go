@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+)
+
+type AccountService interface {
+	CreateAccount(ctx context.Context, userID uint, account *entities.Account) (*entities.Account, error)
+	GetAccountByID(ctx context.Context, userID, accountID uint) (*entities.Account, error)
+	GetAccountsByUser(ctx context.Context, userID uint, includeArchived bool) ([]*entities.Account, error)
+	UpdateAccount(ctx context.Context, userID, accountID uint, updates *entities.Account) (*entities.Account, error)
+	DeleteAccount(ctx context.Context, userID, accountID uint) error
+	SetArchived(ctx context.Context, userID, accountID uint, archived bool) (*entities.Account, error)
+	GetAccountBalances(ctx context.Context, userID uint) ([]repositories.AccountBalance, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type AttachmentService interface {
+	// UploadAttachment valida tipo, tamanho e cota do usuário e grava o arquivo no armazenamento
+	UploadAttachment(ctx context.Context, userID, transactionID uint, fileName string, content io.Reader) (*entities.Attachment, error)
+	GetAttachments(ctx context.Context, userID, transactionID uint) ([]*entities.Attachment, error)
+	// OpenAttachment retorna o anexo e o conteúdo do arquivo; o chamador deve fechar o leitor
+	OpenAttachment(ctx context.Context, userID, transactionID, attachmentID uint) (*entities.Attachment, io.ReadCloser, error)
+	// OpenThumbnail retorna a miniatura JPEG de um anexo de imagem
+	OpenThumbnail(ctx context.Context, userID, transactionID, attachmentID uint) (*entities.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, userID, transactionID, attachmentID uint) error
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStorage abstrai o armazenamento de arquivos (anexos e miniaturas).
+// As chaves são caminhos relativos separados por "/".
+type BlobStorage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Get abre o arquivo para leitura; o chamador deve fechá-lo
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type BudgetService interface {
+	CreateBudget(ctx context.Context, userID uint, budget *entities.Budget) (*entities.Budget, error)
+	GetBudgetByID(ctx context.Context, userID, budgetID uint) (*entities.Budget, error)
+	GetBudgetsByUser(ctx context.Context, userID uint) ([]*entities.Budget, error)
+	UpdateBudget(ctx context.Context, userID, budgetID uint, updates *entities.Budget) (*entities.Budget, error)
+	DeleteBudget(ctx context.Context, userID, budgetID uint) error
+	// GetBudgetStatus compara, para cada orçamento vigente no mês, o planejado (com o
+	// saldo acumulado dos meses anteriores quando houver rollover) com as despesas da categoria
+	GetBudgetStatus(ctx context.Context, userID uint, year, month int) ([]*entities.BudgetStatus, error)
+}
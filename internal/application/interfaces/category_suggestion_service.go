@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type CategorySuggestionService interface {
+	// SuggestCategory sugere categorias para a descrição com base nas transações já
+	// categorizadas pelo usuário, da mais para a menos provável
+	SuggestCategory(ctx context.Context, userID uint, description string, limit int) ([]*entities.CategorySuggestion, error)
+}
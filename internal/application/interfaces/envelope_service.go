@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"time"
+)
+
+type EnvelopeService interface {
+	// EnableEnvelopes ativa o orçamento por envelopes a partir do mês informado
+	EnableEnvelopes(ctx context.Context, userID uint, startMonth time.Time) (*entities.EnvelopeSettings, error)
+	GetEnvelopeSettings(ctx context.Context, userID uint) (*entities.EnvelopeSettings, error)
+	// DisableEnvelopes desativa o modo e exclui o razão de envelopes do usuário
+	DisableEnvelopes(ctx context.Context, userID uint) error
+	// GetEnvelopeMonth calcula o valor a atribuir e os saldos dos envelopes no mês,
+	// gravando no razão os saldos levados entre meses que ainda não refletem as transações
+	GetEnvelopeMonth(ctx context.Context, userID uint, month time.Time) (*entities.EnvelopeMonth, error)
+	// AssignToEnvelope atribui ao envelope parte do valor a atribuir do mês; valor negativo devolve
+	AssignToEnvelope(ctx context.Context, userID uint, month time.Time, categoryID uint, amount float64, note string) (*entities.EnvelopeMonth, error)
+	MoveBetweenEnvelopes(ctx context.Context, userID uint, month time.Time, fromCategoryID, toCategoryID uint, amount float64, note string) (*entities.EnvelopeMonth, error)
+	GetLedger(ctx context.Context, userID uint, filters *repositories.EnvelopeEntryFilters) ([]*entities.EnvelopeEntry, error)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+)
+
+type ExportService interface {
+	// ExportTransactions grava em w as transações que atendem aos filtros, no formato
+	// informado, com nomes de categorias, contas e tags no lugar dos IDs
+	ExportTransactions(ctx context.Context, userID uint, filters *repositories.TransactionFilters, format entities.ExportFormat, w io.Writer) error
+}
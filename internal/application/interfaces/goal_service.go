@@ -11,4 +11,8 @@ type GoalService interface {
 	GetGoalsByUser(ctx context.Context, userID uint) ([]*entities.Goal, error)
 	UpdateGoal(ctx context.Context, userID, goalID uint, updates *entities.Goal) (*entities.Goal, error)
 	DeleteGoal(ctx context.Context, userID, goalID uint) error
+	// GetGoalProgress calcula o progresso da meta a partir das transações vinculadas
+	GetGoalProgress(ctx context.Context, userID, goalID uint) (*entities.GoalProgress, error)
+	// GetGoalsProgress calcula o progresso de todas as metas do usuário
+	GetGoalsProgress(ctx context.Context, userID uint) ([]*entities.GoalProgress, error)
 }
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type ImportService interface {
+	// ImportOFX lê um extrato OFX/QFX. Com commit falso apenas retorna a pré-visualização;
+	// com commit verdadeiro grava as linhas que ainda não foram importadas.
+	ImportOFX(ctx context.Context, userID uint, accountID *uint, content io.Reader, commit bool) (*entities.ImportResult, error)
+	// ImportCSV lê um CSV usando o mapeamento de colunas do perfil. Linhas inválidas são
+	// reportadas no resultado sem impedir a importação das demais. Sem accountID é usada
+	// a conta padrão do perfil.
+	ImportCSV(ctx context.Context, userID, profileID uint, accountID *uint, content io.Reader, commit bool) (*entities.ImportResult, error)
+
+	CreateProfile(ctx context.Context, userID uint, profile *entities.ImportProfile) (*entities.ImportProfile, error)
+	GetProfileByID(ctx context.Context, userID, profileID uint) (*entities.ImportProfile, error)
+	GetProfilesByUser(ctx context.Context, userID uint) ([]*entities.ImportProfile, error)
+	UpdateProfile(ctx context.Context, userID, profileID uint, updates *entities.ImportProfile) (*entities.ImportProfile, error)
+	DeleteProfile(ctx context.Context, userID, profileID uint) error
+}
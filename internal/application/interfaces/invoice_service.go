@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type InvoiceService interface {
+	// GetInvoicesByAccount lista as faturas do cartão, opcionalmente filtrando pela situação
+	GetInvoicesByAccount(ctx context.Context, userID, accountID uint, status *entities.InvoiceStatus) ([]*entities.Invoice, error)
+	GetInvoiceByID(ctx context.Context, userID, invoiceID uint) (*entities.Invoice, error)
+	GetInvoiceItems(ctx context.Context, userID, invoiceID uint) ([]*entities.Transaction, error)
+	// PayInvoice cria a transferência de pagamento a partir da conta informada e marca os lançamentos como pagos
+	PayInvoice(ctx context.Context, userID, invoiceID, fromAccountID uint, date time.Time) (*entities.Invoice, error)
+}
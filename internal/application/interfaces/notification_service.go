@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type NotificationService interface {
+	// GetNotifications lista as notificações mais recentes e a quantidade de não lidas
+	GetNotifications(ctx context.Context, userID uint, unreadOnly bool, limit int) ([]*entities.Notification, int64, error)
+	MarkAsRead(ctx context.Context, userID, notificationID uint) (*entities.Notification, error)
+	MarkAllAsRead(ctx context.Context, userID uint) (int64, error)
+	GetSettings(ctx context.Context, userID uint) (*entities.NotificationSettings, error)
+	UpdateSettings(ctx context.Context, userID uint, updates *entities.NotificationSettings) (*entities.NotificationSettings, error)
+	// EvaluateTransaction verifica os alertas de uma transação já gravada: uso do
+	// orçamento da categoria, despesa alta e despesa vencida
+	EvaluateTransaction(ctx context.Context, userID uint, transaction *entities.Transaction) error
+	// NotifyOverdueExpenses gera os alertas de despesas vencidas de todos os usuários
+	NotifyOverdueExpenses(ctx context.Context) error
+}
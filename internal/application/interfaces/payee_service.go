@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type PayeeService interface {
+	CreatePayee(ctx context.Context, userID uint, payee *entities.Payee) (*entities.Payee, error)
+	GetPayeeByID(ctx context.Context, userID, payeeID uint) (*entities.Payee, error)
+	GetPayeesByUser(ctx context.Context, userID uint) ([]*entities.Payee, error)
+	UpdatePayee(ctx context.Context, userID, payeeID uint, updates *entities.Payee) (*entities.Payee, error)
+	DeletePayee(ctx context.Context, userID, payeeID uint) error
+	// AssignPayees reconhece o favorecido de transações ainda não gravadas pelos
+	// apelidos e aplica a categoria padrão às que estão sem categoria
+	AssignPayees(ctx context.Context, userID uint, transactions []*entities.Transaction) error
+}
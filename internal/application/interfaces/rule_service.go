@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type RuleService interface {
+	CreateRule(ctx context.Context, userID uint, rule *entities.Rule) (*entities.Rule, error)
+	GetRuleByID(ctx context.Context, userID, ruleID uint) (*entities.Rule, error)
+	GetRulesByUser(ctx context.Context, userID uint) ([]*entities.Rule, error)
+	UpdateRule(ctx context.Context, userID, ruleID uint, updates *entities.Rule) (*entities.Rule, error)
+	DeleteRule(ctx context.Context, userID, ruleID uint) error
+	// PreviewRule simula a regra sobre as transações existentes, sem gravar nada
+	PreviewRule(ctx context.Context, userID, ruleID uint, overwrite bool) ([]*entities.RuleChange, error)
+	// ApplyRule aplica a regra retroativamente às transações existentes
+	ApplyRule(ctx context.Context, userID, ruleID uint, overwrite bool) ([]*entities.RuleChange, error)
+	// ApplyActiveRules aplica as regras ativas do usuário a transações ainda não gravadas
+	ApplyActiveRules(ctx context.Context, userID uint, transactions []*entities.Transaction) error
+}
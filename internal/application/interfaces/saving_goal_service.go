@@ -3,6 +3,7 @@ package interfaces
 import (
 	"context"
 	"my-finance-hub-api/internal/domain/entities"
+	"time"
 )
 
 type SavingGoalService interface {
@@ -11,5 +12,11 @@ type SavingGoalService interface {
 	GetSavingGoalsByUser(ctx context.Context, userID uint) ([]*entities.SavingGoal, error)
 	UpdateSavingGoal(ctx context.Context, userID, savingGoalID uint, updates *entities.SavingGoal) (*entities.SavingGoal, error)
 	DeleteSavingGoal(ctx context.Context, userID, savingGoalID uint) error
-	Deposit(ctx context.Context, userID, savingGoalID uint, amount float64) (*entities.SavingGoal, error)
+	// Deposit e Withdraw registram a movimentação no razão do cofrinho; sem data, vale o momento atual
+	Deposit(ctx context.Context, userID, savingGoalID uint, amount float64, date time.Time, note string) (*entities.SavingGoal, error)
+	Withdraw(ctx context.Context, userID, savingGoalID uint, amount float64, date time.Time, note string) (*entities.SavingGoal, error)
+	// GetMovements busca as movimentações do cofrinho com o saldo após cada uma
+	GetMovements(ctx context.Context, userID, savingGoalID uint) ([]*entities.SavingGoalMovement, error)
+	// GetHistory resume as movimentações do cofrinho por mês, até o mês atual
+	GetHistory(ctx context.Context, userID, savingGoalID uint) ([]entities.SavingGoalHistoryPoint, error)
 }
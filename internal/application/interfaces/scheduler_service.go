@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+// JobFunc é a função executada por uma tarefa agendada
+type JobFunc func(ctx context.Context) error
+
+type SchedulerService interface {
+	Register(name, spec string, job JobFunc) error
+	ListJobs(ctx context.Context) ([]*entities.ScheduledJob, error)
+	GetJobRuns(ctx context.Context, name string, limit int) ([]*entities.JobRun, error)
+	// TriggerJob coloca uma execução na fila e a executa em segundo plano, respeitando
+	// o lock entre réplicas; retorna a execução ainda na fila
+	TriggerJob(ctx context.Context, name string) (*entities.JobRun, error)
+	Start(ctx context.Context)
+	Stop()
+}
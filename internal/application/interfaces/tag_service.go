@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type TagService interface {
+	CreateTag(ctx context.Context, userID uint, tag *entities.Tag) (*entities.Tag, error)
+	GetTagByID(ctx context.Context, userID, tagID uint) (*entities.Tag, error)
+	GetTagsByUser(ctx context.Context, userID uint) ([]*entities.Tag, error)
+	UpdateTag(ctx context.Context, userID, tagID uint, updates *entities.Tag) (*entities.Tag, error)
+	DeleteTag(ctx context.Context, userID, tagID uint) error
+}
@@ -9,15 +9,46 @@ import (
 
 type TransactionService interface {
 	CreateTransaction(ctx context.Context, userID uint, transaction *entities.Transaction) (*entities.Transaction, error)
+	// CreateInstallmentPurchase cria uma compra parcelada; a primeira parcela é a transação pai das demais
+	CreateInstallmentPurchase(ctx context.Context, userID uint, transaction *entities.Transaction, installments int, interestRate float64, remainderOnLast bool) ([]*entities.Transaction, error)
+	// CreateTransfer cria as pernas de débito e crédito de uma transferência entre contas
+	CreateTransfer(ctx context.Context, userID, fromAccountID, toAccountID uint, transfer *entities.Transaction) ([]*entities.Transaction, error)
+	GetInstallments(ctx context.Context, userID, transactionID uint) ([]*entities.Transaction, error)
+	// UpdateRemainingInstallments altera as parcelas não pagas a partir da parcela informada
+	UpdateRemainingInstallments(ctx context.Context, userID, transactionID uint, updates *entities.Transaction) ([]*entities.Transaction, error)
+	// CancelRemainingInstallments exclui as parcelas não pagas a partir da parcela informada
+	CancelRemainingInstallments(ctx context.Context, userID, transactionID uint) error
 	GetTransactionByID(ctx context.Context, userID, transactionID uint) (*entities.Transaction, error)
 	GetTransactionsByUser(ctx context.Context, userID uint, filters *repositories.TransactionFilters) ([]*entities.Transaction, error)
+	// ListTransactions retorna uma página das transações filtradas, com os totais do filtro inteiro
+	ListTransactions(ctx context.Context, userID uint, filters *repositories.TransactionFilters, page *repositories.TransactionPageRequest) (*repositories.TransactionPage, error)
+	// SearchTransactions busca por texto na descrição, observações, favorecido e tags,
+	// combinando com os filtros da listagem
+	SearchTransactions(ctx context.Context, userID uint, text string, filters *repositories.TransactionFilters, limit int) ([]*entities.TransactionSearchHit, error)
 	UpdateTransaction(ctx context.Context, userID, transactionID uint, updates *entities.Transaction) (*entities.Transaction, error)
 	DeleteTransaction(ctx context.Context, userID, transactionID uint) error
+	// FindDuplicateCandidates retorna, para cada transação informada, os IDs das transações
+	// já gravadas que provavelmente representam o mesmo lançamento
+	FindDuplicateCandidates(ctx context.Context, userID uint, transactions []*entities.Transaction) ([][]uint, error)
+	// GetDuplicateGroups agrupa as transações do usuário que parecem duplicadas, para revisão
+	GetDuplicateGroups(ctx context.Context, userID uint) ([]*entities.DuplicateGroup, error)
+	// MergeDuplicates mantém a transação keepID e exclui as duplicatas, herdando delas
+	// a categoria (quando a mantida não tiver), as tags e os anexos
+	MergeDuplicates(ctx context.Context, userID, keepID uint, duplicateIDs []uint) (*entities.Transaction, error)
 	TogglePaidStatus(ctx context.Context, userID, transactionID uint) (*entities.Transaction, error)
+	// BulkUpdateTransactions aplica a mesma operação a várias transações do usuário,
+	// validando cada uma como GetTransactionByID. As válidas são gravadas em uma única
+	// transação de banco e o resultado informa o que aconteceu com cada ID.
+	BulkUpdateTransactions(ctx context.Context, userID uint, transactionIDs []uint, operation *entities.BulkOperation) ([]*entities.BulkItemResult, error)
 	// GetTransactionStats obtém estatísticas de transações com suporte a filtros opcionais
 	GetTransactionStats(ctx context.Context, userID uint, filters *repositories.TransactionFilters) (map[string]interface{}, error)
 	GetReports(ctx context.Context, userID uint, filters *repositories.TransactionFilters) (map[string]interface{}, error)
 	GetDashboardReports(ctx context.Context, userID uint) (map[string]interface{}, error)
+	// CreateRecurringTransactions gera as ocorrências pendentes de um modelo recorrente (idempotente)
 	CreateRecurringTransactions(ctx context.Context, transaction *entities.Transaction) error
+	// GenerateRecurringTransactions gera as ocorrências pendentes de todas as séries do usuário
+	GenerateRecurringTransactions(ctx context.Context, userID uint) error
+	// GenerateAllRecurringTransactions gera as ocorrências pendentes das séries de todos os usuários
+	GenerateAllRecurringTransactions(ctx context.Context) error
 	GetMonthlyStats(ctx context.Context, userID uint, year int, startDate, endDate *time.Time) ([]repositories.MonthlyStats, error)
 }
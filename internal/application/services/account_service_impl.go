@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+)
+
+type accountServiceImpl struct {
+	accountRepo repositories.AccountRepository
+}
+
+func NewAccountService(accountRepo repositories.AccountRepository) interfaces.AccountService {
+	return &accountServiceImpl{
+		accountRepo: accountRepo,
+	}
+}
+
+func (s *accountServiceImpl) CreateAccount(ctx context.Context, userID uint, account *entities.Account) (*entities.Account, error) {
+	// Validações
+	if account.Name == "" {
+		return nil, pkgErrors.NewDomainError("validation_error", "Nome da conta é obrigatório")
+	}
+
+	if !account.Kind.IsValid() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Tipo de conta inválido")
+	}
+
+	if err := validateCardCycle(account); err != nil {
+		return nil, err
+	}
+
+	// Verificar se já existe uma conta com o mesmo nome para o usuário
+	exists, err := s.accountRepo.ExistsByName(ctx, userID, account.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, pkgErrors.NewDomainError("already_exists", "Já existe uma conta com este nome")
+	}
+
+	// Criar nova conta
+	newAccount := entities.NewAccount(account.Name, account.Kind, account.Institution, account.OpeningBalance, account.Currency, userID)
+	if newAccount.IsCreditCard() {
+		newAccount.SetCardCycle(account.ClosingDay, account.DueDay)
+	}
+
+	if err := s.accountRepo.Create(ctx, newAccount); err != nil {
+		return nil, err
+	}
+
+	return newAccount, nil
+}
+
+func (s *accountServiceImpl) GetAccountByID(ctx context.Context, userID, accountID uint) (*entities.Account, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se a conta pertence ao usuário
+	if !account.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return account, nil
+}
+
+func (s *accountServiceImpl) GetAccountsByUser(ctx context.Context, userID uint, includeArchived bool) ([]*entities.Account, error) {
+	return s.accountRepo.GetByUserID(ctx, userID, includeArchived)
+}
+
+func (s *accountServiceImpl) UpdateAccount(ctx context.Context, userID, accountID uint, updates *entities.Account) (*entities.Account, error) {
+	// Buscar conta existente
+	account, err := s.GetAccountByID(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validações
+	if updates.Name == "" {
+		return nil, pkgErrors.NewDomainError("validation_error", "Nome da conta é obrigatório")
+	}
+
+	if !updates.Kind.IsValid() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Tipo de conta inválido")
+	}
+
+	if err := validateCardCycle(updates); err != nil {
+		return nil, err
+	}
+
+	// Verificar se o novo nome já existe (se foi alterado)
+	if updates.Name != account.Name {
+		exists, err := s.accountRepo.ExistsByName(ctx, userID, updates.Name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, pkgErrors.NewDomainError("already_exists", "Já existe uma conta com este nome")
+		}
+	}
+
+	// Atualizar conta
+	account.Update(updates.Name, updates.Kind, updates.Institution, updates.OpeningBalance, updates.Currency)
+	if account.IsCreditCard() {
+		account.SetCardCycle(updates.ClosingDay, updates.DueDay)
+	} else {
+		account.SetCardCycle(0, 0)
+	}
+
+	if err := s.accountRepo.Update(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *accountServiceImpl) DeleteAccount(ctx context.Context, userID, accountID uint) error {
+	// Verificar se a conta existe e pertence ao usuário
+	_, err := s.GetAccountByID(ctx, userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	// Contas com lançamentos devem ser arquivadas para preservar o histórico
+	hasTransactions, err := s.accountRepo.HasTransactions(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if hasTransactions {
+		return pkgErrors.NewDomainError("validation_error", "Conta possui transações; arquive-a em vez de excluir")
+	}
+
+	// Excluir conta
+	return s.accountRepo.Delete(ctx, accountID)
+}
+
+func (s *accountServiceImpl) SetArchived(ctx context.Context, userID, accountID uint, archived bool) (*entities.Account, error) {
+	account, err := s.GetAccountByID(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account.SetArchived(archived)
+
+	if err := s.accountRepo.Update(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *accountServiceImpl) GetAccountBalances(ctx context.Context, userID uint) ([]repositories.AccountBalance, error) {
+	return s.accountRepo.GetBalances(ctx, userID, nil)
+}
+
+// validateCardCycle exige dias de fechamento e vencimento válidos para cartões de crédito
+func validateCardCycle(account *entities.Account) error {
+	if !account.IsCreditCard() {
+		return nil
+	}
+
+	if account.ClosingDay < 1 || account.ClosingDay > 31 || account.DueDay < 1 || account.DueDay > 31 {
+		return pkgErrors.NewDomainError("validation_error", "Cartão de crédito exige dia de fechamento e de vencimento entre 1 e 31")
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"log"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// thumbnailMaxSide é o maior lado, em pixels, das miniaturas geradas
+	thumbnailMaxSide = 256
+	// thumbnailMaxPixels evita decodificar imagens gigantes apenas para a miniatura
+	thumbnailMaxPixels = 40_000_000
+)
+
+type attachmentServiceImpl struct {
+	attachmentRepo  repositories.AttachmentRepository
+	transactionRepo repositories.TransactionRepository
+	storage         interfaces.BlobStorage
+	maxSize         int64
+	userQuota       int64
+}
+
+func NewAttachmentService(attachmentRepo repositories.AttachmentRepository, transactionRepo repositories.TransactionRepository, storage interfaces.BlobStorage, maxSize, userQuota int64) interfaces.AttachmentService {
+	return &attachmentServiceImpl{
+		attachmentRepo:  attachmentRepo,
+		transactionRepo: transactionRepo,
+		storage:         storage,
+		maxSize:         maxSize,
+		userQuota:       userQuota,
+	}
+}
+
+func (s *attachmentServiceImpl) UploadAttachment(ctx context.Context, userID, transactionID uint, fileName string, content io.Reader) (*entities.Attachment, error) {
+	if err := s.checkTransaction(ctx, userID, transactionID); err != nil {
+		return nil, err
+	}
+
+	// Ler no máximo um byte além do limite para detectar arquivos grandes demais
+	data, err := io.ReadAll(io.LimitReader(content, s.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	// Validações
+	if len(data) == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Arquivo vazio")
+	}
+
+	if int64(len(data)) > s.maxSize {
+		return nil, pkgErrors.NewDomainErrorWithDetails("validation_error", "Arquivo excede o tamanho máximo permitido",
+			fmt.Sprintf("tamanho máximo: %d MB", s.maxSize>>20))
+	}
+
+	// O tipo é detectado pelo conteúdo, não pela extensão informada
+	contentType := http.DetectContentType(data)
+	ext, ok := entities.AttachmentExtension(contentType)
+	if !ok {
+		return nil, pkgErrors.NewDomainErrorWithDetails("validation_error", "Tipo de arquivo não suportado",
+			"envie arquivos PDF, JPEG ou PNG")
+	}
+
+	// Verificação antecipada para não gravar no armazenamento um arquivo que não cabe
+	// na cota; a verificação definitiva é feita junto com a gravação do anexo
+	used, err := s.attachmentRepo.GetTotalSizeByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if used+int64(len(data)) > s.userQuota {
+		return nil, s.quotaError(used)
+	}
+
+	attachment := entities.NewAttachment(transactionID, userID, sanitizeFileName(fileName, ext), contentType, int64(len(data)))
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	attachment.StorageKey = fmt.Sprintf("attachments/%d/%d/%s%s", userID, transactionID, token, ext)
+
+	if err := s.storage.Put(ctx, attachment.StorageKey, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	// A miniatura é opcional: falhas apenas deixam o anexo sem pré-visualização
+	if attachment.IsImage() {
+		thumbnail, err := makeThumbnail(data)
+		if err != nil {
+			log.Printf("Erro ao gerar miniatura do anexo %s: %v", attachment.StorageKey, err)
+		} else {
+			thumbnailKey := strings.TrimSuffix(attachment.StorageKey, ext) + ".thumb.jpg"
+			if err := s.storage.Put(ctx, thumbnailKey, bytes.NewReader(thumbnail)); err != nil {
+				log.Printf("Erro ao gravar miniatura do anexo %s: %v", attachment.StorageKey, err)
+			} else {
+				attachment.ThumbnailKey = thumbnailKey
+			}
+		}
+	}
+
+	if used, err := s.attachmentRepo.CreateWithinQuota(ctx, attachment, s.userQuota); err != nil {
+		s.deleteFiles(ctx, attachment)
+		if errors.Is(err, pkgErrors.ErrAttachmentQuota) {
+			return nil, s.quotaError(used)
+		}
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// quotaError informa o espaço já utilizado quando o anexo não cabe na cota
+func (s *attachmentServiceImpl) quotaError(used int64) error {
+	return pkgErrors.NewDomainErrorWithDetails("validation_error", pkgErrors.ErrAttachmentQuota.Message,
+		fmt.Sprintf("utilizado: %.1f MB de %d MB", float64(used)/(1<<20), s.userQuota>>20))
+}
+
+func (s *attachmentServiceImpl) GetAttachments(ctx context.Context, userID, transactionID uint) ([]*entities.Attachment, error) {
+	if err := s.checkTransaction(ctx, userID, transactionID); err != nil {
+		return nil, err
+	}
+
+	return s.attachmentRepo.GetByTransactionID(ctx, transactionID)
+}
+
+func (s *attachmentServiceImpl) OpenAttachment(ctx context.Context, userID, transactionID, attachmentID uint) (*entities.Attachment, io.ReadCloser, error) {
+	attachment, err := s.getAttachment(ctx, userID, transactionID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+func (s *attachmentServiceImpl) OpenThumbnail(ctx context.Context, userID, transactionID, attachmentID uint) (*entities.Attachment, io.ReadCloser, error) {
+	attachment, err := s.getAttachment(ctx, userID, transactionID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !attachment.HasThumbnail() {
+		return nil, nil, pkgErrors.NewDomainError("not_found", "Anexo não possui miniatura")
+	}
+
+	content, err := s.storage.Get(ctx, attachment.ThumbnailKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+func (s *attachmentServiceImpl) DeleteAttachment(ctx context.Context, userID, transactionID, attachmentID uint) error {
+	attachment, err := s.getAttachment(ctx, userID, transactionID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		return err
+	}
+
+	s.deleteFiles(ctx, attachment)
+
+	return nil
+}
+
+// checkTransaction verifica se a transação existe e pertence ao usuário
+func (s *attachmentServiceImpl) checkTransaction(ctx context.Context, userID, transactionID uint) error {
+	transaction, err := s.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if !transaction.BelongsToUser(userID) {
+		return pkgErrors.ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *attachmentServiceImpl) getAttachment(ctx context.Context, userID, transactionID, attachmentID uint) (*entities.Attachment, error) {
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.TransactionID != transactionID {
+		return nil, pkgErrors.ErrAttachmentNotFound
+	}
+
+	// Verificar se o anexo pertence ao usuário
+	if !attachment.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return attachment, nil
+}
+
+// deleteFiles remove o arquivo e a miniatura do armazenamento, apenas registrando falhas
+func (s *attachmentServiceImpl) deleteFiles(ctx context.Context, attachment *entities.Attachment) {
+	for _, key := range []string{attachment.StorageKey, attachment.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+		if err := s.storage.Delete(ctx, key); err != nil {
+			log.Printf("Erro ao remover arquivo %s do armazenamento: %v", key, err)
+		}
+	}
+}
+
+// sanitizeFileName mantém apenas o nome base do arquivo enviado, garantindo a extensão do tipo detectado
+func sanitizeFileName(fileName, ext string) string {
+	name := strings.TrimSpace(filepath.Base(strings.ReplaceAll(fileName, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		name = "anexo"
+	}
+	if runes := []rune(name); len(runes) > 200 {
+		name = string(runes[:200])
+	}
+	if current := strings.ToLower(filepath.Ext(name)); current != ext && !(ext == ".jpg" && current == ".jpeg") {
+		name += ext
+	}
+	return name
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// makeThumbnail reduz a imagem (JPEG ou PNG) para caber em thumbnailMaxSide, calculando
+// a média dos pixels de origem de cada pixel da miniatura, e codifica o resultado em JPEG.
+// A origem é convertida por image/draw uma faixa de linhas por vez, sobre fundo branco
+// (para PNGs com transparência), e a média é feita direto nos bytes da faixa.
+func makeThumbnail(data []byte) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > thumbnailMaxPixels {
+		return nil, fmt.Errorf("imagem muito grande para miniatura: %dx%d", config.Width, config.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	thumbWidth, thumbHeight := width, height
+	if longest := max(width, height); longest > thumbnailMaxSide {
+		thumbWidth = max(1, width*thumbnailMaxSide/longest)
+		thumbHeight = max(1, height*thumbnailMaxSide/longest)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	band := image.NewRGBA(image.Rect(0, 0, width, height/thumbHeight+2))
+	for y := 0; y < thumbHeight; y++ {
+		y0 := y * height / thumbHeight
+		y1 := max(y0+1, (y+1)*height/thumbHeight)
+
+		rows := image.Rect(0, 0, width, y1-y0)
+		draw.Draw(band, rows, image.White, image.Point{}, draw.Src)
+		draw.Draw(band, rows, src, image.Pt(bounds.Min.X, bounds.Min.Y+y0), draw.Over)
+
+		for x := 0; x < thumbWidth; x++ {
+			x0 := x * width / thumbWidth
+			x1 := max(x0+1, (x+1)*width/thumbWidth)
+
+			var r, g, b, count uint64
+			for sy := 0; sy < y1-y0; sy++ {
+				row := band.Pix[sy*band.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					r, g, b = r+uint64(row[4*sx]), g+uint64(row[4*sx+1]), b+uint64(row[4*sx+2])
+					count++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i], dst.Pix[i+1], dst.Pix[i+2], dst.Pix[i+3] = uint8(r/count), uint8(g/count), uint8(b/count), 0xff
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+)
+
+type budgetServiceImpl struct {
+	budgetRepo      repositories.BudgetRepository
+	categoryRepo    repositories.CategoryRepository
+	transactionRepo repositories.TransactionRepository
+}
+
+func NewBudgetService(budgetRepo repositories.BudgetRepository, categoryRepo repositories.CategoryRepository, transactionRepo repositories.TransactionRepository) interfaces.BudgetService {
+	return &budgetServiceImpl{
+		budgetRepo:      budgetRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func (s *budgetServiceImpl) CreateBudget(ctx context.Context, userID uint, budget *entities.Budget) (*entities.Budget, error) {
+	// Sem mês inicial, o orçamento vale a partir do mês atual
+	startMonth := budget.StartMonth
+	if startMonth.IsZero() {
+		startMonth = time.Now()
+	}
+
+	newBudget := entities.NewBudget(budget.CategoryID, budget.Amount, budget.Rollover, startMonth, userID)
+
+	if err := s.validateBudget(ctx, userID, newBudget); err != nil {
+		return nil, err
+	}
+
+	// Cada categoria tem um único orçamento mensal
+	exists, err := s.budgetRepo.ExistsByCategory(ctx, userID, newBudget.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, pkgErrors.NewDomainError("already_exists", "Já existe um orçamento para esta categoria")
+	}
+
+	if err := s.budgetRepo.Create(ctx, newBudget); err != nil {
+		return nil, err
+	}
+
+	return newBudget, nil
+}
+
+func (s *budgetServiceImpl) GetBudgetByID(ctx context.Context, userID, budgetID uint) (*entities.Budget, error) {
+	budget, err := s.budgetRepo.GetByID(ctx, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se o orçamento pertence ao usuário
+	if !budget.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return budget, nil
+}
+
+func (s *budgetServiceImpl) GetBudgetsByUser(ctx context.Context, userID uint) ([]*entities.Budget, error) {
+	return s.budgetRepo.GetByUserID(ctx, userID)
+}
+
+func (s *budgetServiceImpl) UpdateBudget(ctx context.Context, userID, budgetID uint, updates *entities.Budget) (*entities.Budget, error) {
+	budget, err := s.GetBudgetByID(ctx, userID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	startMonth := updates.StartMonth
+	if startMonth.IsZero() {
+		startMonth = budget.StartMonth
+	}
+
+	// A categoria não muda; para outra categoria, crie outro orçamento
+	budget.Update(updates.Amount, updates.Rollover, startMonth)
+
+	if err := s.validateBudget(ctx, userID, budget); err != nil {
+		return nil, err
+	}
+
+	if err := s.budgetRepo.Update(ctx, budget); err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+func (s *budgetServiceImpl) DeleteBudget(ctx context.Context, userID, budgetID uint) error {
+	if _, err := s.GetBudgetByID(ctx, userID, budgetID); err != nil {
+		return err
+	}
+
+	return s.budgetRepo.Delete(ctx, budgetID)
+}
+
+func (s *budgetServiceImpl) GetBudgetStatus(ctx context.Context, userID uint, year, month int) ([]*entities.BudgetStatus, error) {
+	if month < 1 || month > 12 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Mês inválido")
+	}
+
+	budgets, err := s.budgetRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	monthStart := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+
+	// Categorias excluídas deixam de ter acompanhamento
+	categories, err := s.categoryRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	categoryNames := make(map[uint]string, len(categories))
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+	}
+
+	var active []*entities.Budget
+	rolloverStart := time.Time{}
+	for _, budget := range budgets {
+		if _, ok := categoryNames[budget.CategoryID]; !ok || !budget.IsActiveIn(monthStart) {
+			continue
+		}
+		active = append(active, budget)
+		if budget.Rollover && budget.StartMonth.Before(monthStart) && (rolloverStart.IsZero() || budget.StartMonth.Before(rolloverStart)) {
+			rolloverStart = budget.StartMonth
+		}
+	}
+
+	if len(active) == 0 {
+		return []*entities.BudgetStatus{}, nil
+	}
+
+	expenseType := string(entities.EXPENSE)
+
+	// Realizado do mês pela mesma agregação dos relatórios por categoria
+	totals, err := s.transactionRepo.GetCategoryTotals(ctx, userID, &repositories.TransactionFilters{
+		StartDate: monthStart,
+		EndDate:   monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond),
+		Type:      &expenseType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	actual := make(map[uint]float64, len(totals))
+	for _, total := range totals {
+		actual[total.CategoryID] += total.Total
+	}
+
+	// Despesas dos meses anteriores, para o saldo acumulado dos orçamentos com rollover
+	spent := make(map[uint]map[string]float64)
+	if !rolloverStart.IsZero() {
+		monthly, err := s.transactionRepo.GetCategoryMonthlyTotals(ctx, userID, &repositories.TransactionFilters{
+			StartDate: rolloverStart,
+			EndDate:   monthStart.Add(-time.Nanosecond),
+			Type:      &expenseType,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, total := range monthly {
+			if spent[total.CategoryID] == nil {
+				spent[total.CategoryID] = make(map[string]float64)
+			}
+			spent[total.CategoryID][total.Month] = total.Total
+		}
+	}
+
+	statuses := make([]*entities.BudgetStatus, len(active))
+	for i, budget := range active {
+		carryover := budget.Carryover(monthStart, spent[budget.CategoryID])
+		statuses[i] = budget.Status(monthStart, actual[budget.CategoryID], carryover)
+		statuses[i].CategoryName = categoryNames[budget.CategoryID]
+	}
+
+	return statuses, nil
+}
+
+func (s *budgetServiceImpl) validateBudget(ctx context.Context, userID uint, budget *entities.Budget) error {
+	if budget.Amount <= 0 {
+		return pkgErrors.NewDomainError("validation_error", "Valor do orçamento deve ser maior que zero")
+	}
+
+	category, err := s.categoryRepo.GetByID(ctx, budget.CategoryID)
+	if err != nil {
+		return err
+	}
+	if !category.BelongsToUser(userID) {
+		return pkgErrors.ErrForbidden
+	}
+
+	return nil
+}
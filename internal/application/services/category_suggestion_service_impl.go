@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/pkg/classifier"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSuggestionLimit = 3
+	maxSuggestionLimit     = 10
+)
+
+// categoryModel é o classificador de um usuário. learned guarda o que foi aprendido
+// de cada transação para que uma alteração posterior possa ser desfeita.
+type categoryModel struct {
+	mu         sync.Mutex
+	classifier *classifier.NaiveBayes
+	learned    map[uint]learnedDescription
+	watermark  time.Time
+}
+
+type learnedDescription struct {
+	categoryID uint
+	tokens     []string
+}
+
+// categorySuggestionServiceImpl mantém um modelo em memória por usuário. O primeiro
+// pedido treina com todo o histórico; os seguintes aplicam apenas as transações
+// alteradas ou excluídas desde o último treino.
+type categorySuggestionServiceImpl struct {
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+
+	mu     sync.Mutex
+	models map[uint]*categoryModel
+}
+
+func NewCategorySuggestionService(transactionRepo repositories.TransactionRepository, categoryRepo repositories.CategoryRepository) interfaces.CategorySuggestionService {
+	return &categorySuggestionServiceImpl{
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		models:          make(map[uint]*categoryModel),
+	}
+}
+
+func (s *categorySuggestionServiceImpl) SuggestCategory(ctx context.Context, userID uint, description string, limit int) ([]*entities.CategorySuggestion, error) {
+	tokens := entities.DescriptionTokens(description)
+	if len(tokens) == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Descrição deve conter ao menos uma palavra")
+	}
+
+	if limit <= 0 {
+		limit = defaultSuggestionLimit
+	}
+	if limit > maxSuggestionLimit {
+		limit = maxSuggestionLimit
+	}
+
+	model := s.model(userID)
+
+	model.mu.Lock()
+	err := s.refresh(ctx, userID, model)
+	var predictions []classifier.Prediction
+	if err == nil {
+		predictions = model.classifier.Predict(tokens)
+	}
+	model.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*entities.CategorySuggestion, 0, limit)
+	if len(predictions) == 0 {
+		return suggestions, nil
+	}
+
+	// Categorias excluídas podem continuar no modelo até que as transações sejam alteradas
+	categories, err := s.categoryRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	categoryByID := make(map[uint]*entities.Category, len(categories))
+	for _, category := range categories {
+		categoryByID[category.ID] = category
+	}
+
+	for _, prediction := range predictions {
+		category, ok := categoryByID[prediction.Class]
+		if !ok {
+			continue
+		}
+		suggestions = append(suggestions, &entities.CategorySuggestion{
+			Category:   category,
+			Confidence: prediction.Probability,
+		})
+		if len(suggestions) == limit {
+			break
+		}
+	}
+
+	return suggestions, nil
+}
+
+func (s *categorySuggestionServiceImpl) model(userID uint) *categoryModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model, ok := s.models[userID]
+	if !ok {
+		model = &categoryModel{
+			classifier: classifier.NewNaiveBayes(),
+			learned:    make(map[uint]learnedDescription),
+		}
+		s.models[userID] = model
+	}
+
+	return model
+}
+
+// refresh atualiza o modelo com as transações alteradas desde o último treino. A
+// consulta inclui o próprio instante do último treino, e reaplicar uma transação
+// já aprendida não altera o modelo.
+func (s *categorySuggestionServiceImpl) refresh(ctx context.Context, userID uint, model *categoryModel) error {
+	changes, err := s.transactionRepo.GetCategorizationChanges(ctx, userID, model.watermark)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		if previous, ok := model.learned[change.TransactionID]; ok {
+			model.classifier.Forget(previous.categoryID, previous.tokens)
+			delete(model.learned, change.TransactionID)
+		}
+
+		if !change.Deleted && change.CategoryID != nil {
+			tokens := entities.DescriptionTokens(change.Description)
+			if len(tokens) > 0 {
+				model.classifier.Learn(*change.CategoryID, tokens)
+				model.learned[change.TransactionID] = learnedDescription{
+					categoryID: *change.CategoryID,
+					tokens:     tokens,
+				}
+			}
+		}
+
+		if change.ChangedAt.After(model.watermark) {
+			model.watermark = change.ChangedAt
+		}
+	}
+
+	return nil
+}
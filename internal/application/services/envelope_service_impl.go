@@ -0,0 +1,359 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+)
+
+var (
+	errEnvelopesDisabled   = pkgErrors.NewDomainError("validation_error", "Orçamento por envelopes não está ativado")
+	errEnvelopeMonthTooFar = pkgErrors.NewDomainError("validation_error",
+		fmt.Sprintf("Mês deve ser no máximo %d meses depois do atual", entities.EnvelopeMaxMonthsAhead))
+)
+
+type envelopeServiceImpl struct {
+	envelopeRepo    repositories.EnvelopeRepository
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+}
+
+// envelopeState é o razão do usuário recalculado mês a mês até o último mês relevante
+type envelopeState struct {
+	settings   *entities.EnvelopeSettings
+	entries    []*entities.EnvelopeEntry
+	months     []*entities.EnvelopeMonth
+	categories map[uint]*entities.Category
+}
+
+func NewEnvelopeService(envelopeRepo repositories.EnvelopeRepository, transactionRepo repositories.TransactionRepository, categoryRepo repositories.CategoryRepository) interfaces.EnvelopeService {
+	return &envelopeServiceImpl{
+		envelopeRepo:    envelopeRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+	}
+}
+
+func (s *envelopeServiceImpl) EnableEnvelopes(ctx context.Context, userID uint, startMonth time.Time) (*entities.EnvelopeSettings, error) {
+	existing, err := s.envelopeRepo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, pkgErrors.NewDomainError("already_exists", "Orçamento por envelopes já está ativado")
+	}
+
+	// Sem mês inicial, os envelopes começam no mês atual
+	if startMonth.IsZero() {
+		startMonth = time.Now()
+	}
+	if startMonth.After(entities.EnvelopeHorizon(time.Now())) {
+		return nil, errEnvelopeMonthTooFar
+	}
+
+	settings := entities.NewEnvelopeSettings(userID, startMonth)
+	if err := s.envelopeRepo.CreateSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (s *envelopeServiceImpl) GetEnvelopeSettings(ctx context.Context, userID uint) (*entities.EnvelopeSettings, error) {
+	settings, err := s.envelopeRepo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, errEnvelopesDisabled
+	}
+	return settings, nil
+}
+
+func (s *envelopeServiceImpl) DisableEnvelopes(ctx context.Context, userID uint) error {
+	if _, err := s.GetEnvelopeSettings(ctx, userID); err != nil {
+		return err
+	}
+	return s.envelopeRepo.DeleteSettings(ctx, userID)
+}
+
+// GetEnvelopeMonth calcula o mês sem gravar nada; os ajustes de saldo levado só são
+// gravados nas atribuições e transferências
+func (s *envelopeServiceImpl) GetEnvelopeMonth(ctx context.Context, userID uint, month time.Time) (*entities.EnvelopeMonth, error) {
+	state, err := s.compute(ctx, s.envelopeRepo, userID, month)
+	if err != nil {
+		return nil, err
+	}
+	return state.month(month), nil
+}
+
+func (s *envelopeServiceImpl) AssignToEnvelope(ctx context.Context, userID uint, month time.Time, categoryID uint, amount float64, note string) (*entities.EnvelopeMonth, error) {
+	if amount == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor da atribuição não pode ser zero")
+	}
+
+	var result *entities.EnvelopeMonth
+
+	err := s.envelopeRepo.RunLocked(ctx, userID, func(repo repositories.EnvelopeRepository) error {
+		state, err := s.sync(ctx, repo, userID, month)
+		if err != nil {
+			return err
+		}
+		if err := state.requireEnvelope(categoryID); err != nil {
+			return err
+		}
+
+		if amount > 0 {
+			// O valor a atribuir é acumulado: atribuir agora reduz também os meses seguintes
+			if available := state.minToBeAssignedFrom(month); amount > available {
+				return pkgErrors.NewDomainError("insufficient_funds",
+					fmt.Sprintf("Valor maior que o disponível para atribuir (%.2f)", available))
+			}
+		} else if available := state.available(month, categoryID); -amount > available {
+			return pkgErrors.NewDomainError("insufficient_funds",
+				fmt.Sprintf("Valor maior que o saldo do envelope (%.2f)", available))
+		}
+
+		entry := entities.NewEnvelopeAssignment(userID, categoryID, month, amount, note)
+		if err := repo.CreateEntries(ctx, []*entities.EnvelopeEntry{entry}); err != nil {
+			return err
+		}
+
+		// A atribuição muda os saldos levados aos meses seguintes
+		if state, err = s.sync(ctx, repo, userID, month); err != nil {
+			return err
+		}
+		result = state.month(month)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *envelopeServiceImpl) MoveBetweenEnvelopes(ctx context.Context, userID uint, month time.Time, fromCategoryID, toCategoryID uint, amount float64, note string) (*entities.EnvelopeMonth, error) {
+	if amount <= 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor da transferência deve ser maior que zero")
+	}
+	if fromCategoryID == toCategoryID {
+		return nil, pkgErrors.NewDomainError("validation_error", "Envelopes de origem e destino devem ser diferentes")
+	}
+
+	var result *entities.EnvelopeMonth
+
+	err := s.envelopeRepo.RunLocked(ctx, userID, func(repo repositories.EnvelopeRepository) error {
+		state, err := s.sync(ctx, repo, userID, month)
+		if err != nil {
+			return err
+		}
+		for _, categoryID := range []uint{fromCategoryID, toCategoryID} {
+			if err := state.requireEnvelope(categoryID); err != nil {
+				return err
+			}
+		}
+
+		if available := state.available(month, fromCategoryID); amount > available {
+			return pkgErrors.NewDomainError("insufficient_funds",
+				fmt.Sprintf("Saldo insuficiente no envelope de origem (%.2f)", available))
+		}
+
+		entries := entities.NewEnvelopeMove(userID, fromCategoryID, toCategoryID, month, amount, note)
+		if err := repo.CreateEntries(ctx, entries); err != nil {
+			return err
+		}
+
+		if state, err = s.sync(ctx, repo, userID, month); err != nil {
+			return err
+		}
+		result = state.month(month)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *envelopeServiceImpl) GetLedger(ctx context.Context, userID uint, filters *repositories.EnvelopeEntryFilters) ([]*entities.EnvelopeEntry, error) {
+	if _, err := s.GetEnvelopeSettings(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.envelopeRepo.GetEntries(ctx, userID, filters)
+}
+
+// sync recalcula o razão com compute e grava os ajustes de saldo levado entre meses;
+// deve ser chamado com o razão do usuário bloqueado
+func (s *envelopeServiceImpl) sync(ctx context.Context, repo repositories.EnvelopeRepository, userID uint, month time.Time) (*envelopeState, error) {
+	state, err := s.compute(ctx, repo, userID, month)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.CreateEntries(ctx, entities.CarryoverAdjustments(userID, state.months, state.entries)); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// compute recalcula em memória o razão do início do modo até o último mês relevante
+// (o pedido, o atual ou o do último lançamento), limitado a EnvelopeHorizon
+func (s *envelopeServiceImpl) compute(ctx context.Context, repo repositories.EnvelopeRepository, userID uint, month time.Time) (*envelopeState, error) {
+	settings, err := repo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, errEnvelopesDisabled
+	}
+
+	month = entities.MonthStart(month)
+	if month.Before(settings.StartMonth) {
+		return nil, pkgErrors.NewDomainError("validation_error", "Mês anterior ao início do orçamento por envelopes")
+	}
+
+	now := time.Now()
+	horizon := entities.EnvelopeHorizon(now)
+	if month.After(horizon) {
+		return nil, errEnvelopeMonthTooFar
+	}
+
+	entries, err := repo.GetEntries(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	end := month
+	if current := entities.MonthStart(now); current.After(end) {
+		end = current
+	}
+	for _, entry := range entries {
+		if entry.Month.After(end) {
+			end = entry.Month
+		}
+	}
+	if end.After(horizon) {
+		end = horizon
+	}
+
+	activity, err := s.loadActivity(ctx, userID, settings.StartMonth, end)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Toda categoria de despesa é um envelope, mesmo antes de receber dinheiro
+	state := &envelopeState{settings: settings, entries: entries, categories: make(map[uint]*entities.Category, len(categories))}
+	var envelopeIDs []uint
+	for _, category := range categories {
+		state.categories[category.ID] = category
+		if category.Type == string(entities.EXPENSE) {
+			envelopeIDs = append(envelopeIDs, category.ID)
+		}
+	}
+
+	state.months = entities.ComputeEnvelopeMonths(settings.StartMonth, end, envelopeIDs, entries, activity)
+
+	for _, m := range state.months {
+		for _, balance := range m.Envelopes {
+			if category, ok := state.categories[balance.CategoryID]; ok {
+				balance.CategoryName = category.Name
+			}
+		}
+	}
+
+	return state, nil
+}
+
+// loadActivity busca as receitas e as despesas por categoria de cada mês do intervalo
+func (s *envelopeServiceImpl) loadActivity(ctx context.Context, userID uint, start, end time.Time) (*entities.EnvelopeActivity, error) {
+	endDate := end.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	activity := &entities.EnvelopeActivity{
+		Income: make(map[string]float64),
+		Spent:  make(map[string]map[uint]float64),
+	}
+
+	typeTotals, err := s.transactionRepo.GetMonthlyTypeTotals(ctx, userID, start, endDate)
+	if err != nil {
+		return nil, err
+	}
+	for _, total := range typeTotals {
+		if total.Type == string(entities.INCOME) {
+			activity.Income[total.Month] += total.Total
+		}
+	}
+
+	expenseType := string(entities.EXPENSE)
+	categoryTotals, err := s.transactionRepo.GetCategoryMonthlyTotals(ctx, userID, &repositories.TransactionFilters{
+		StartDate: start,
+		EndDate:   endDate,
+		Type:      &expenseType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, total := range categoryTotals {
+		if activity.Spent[total.Month] == nil {
+			activity.Spent[total.Month] = make(map[uint]float64)
+		}
+		activity.Spent[total.Month][total.CategoryID] += total.Total
+	}
+
+	return activity, nil
+}
+
+func (st *envelopeState) month(month time.Time) *entities.EnvelopeMonth {
+	key := entities.MonthKey(month)
+	for _, m := range st.months {
+		if entities.MonthKey(m.Month) == key {
+			return m
+		}
+	}
+	return nil
+}
+
+func (st *envelopeState) requireEnvelope(categoryID uint) error {
+	category, ok := st.categories[categoryID]
+	if !ok {
+		return pkgErrors.ErrCategoryNotFound
+	}
+	if category.Type != string(entities.EXPENSE) {
+		return pkgErrors.NewDomainError("validation_error", "Apenas categorias de despesa podem ser envelopes")
+	}
+	return nil
+}
+
+func (st *envelopeState) available(month time.Time, categoryID uint) float64 {
+	if balance := st.month(month).Envelope(categoryID); balance != nil {
+		return balance.Available
+	}
+	return 0
+}
+
+// minToBeAssignedFrom é o menor valor a atribuir do mês em diante
+func (st *envelopeState) minToBeAssignedFrom(month time.Time) float64 {
+	month = entities.MonthStart(month)
+	lowest := 0.0
+	first := true
+	for _, m := range st.months {
+		if m.Month.Before(month) {
+			continue
+		}
+		if first || m.ToBeAssigned < lowest {
+			lowest = m.ToBeAssigned
+			first = false
+		}
+	}
+	return lowest
+}
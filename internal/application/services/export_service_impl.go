@@ -0,0 +1,500 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"io"
+	"math"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"my-finance-hub-api/pkg/ofx"
+	"my-finance-hub-api/pkg/xlsx"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportBatchSize é a quantidade de transações lidas do banco por vez
+const exportBatchSize = 500
+
+// Colunas das exportações em CSV e XLSX
+var exportColumns = []string{"Data", "Descrição", "Tipo", "Categoria", "Conta", "Tags", "Valor", "Pago", "Observação", "ID"}
+
+var exportTypeLabels = map[entities.TransactionType]string{
+	entities.INCOME:     "Receita",
+	entities.EXPENSE:    "Despesa",
+	entities.INVESTMENT: "Investimento",
+	entities.TRANSFER:   "Transferência",
+}
+
+type exportServiceImpl struct {
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+	accountRepo     repositories.AccountRepository
+	tagRepo         repositories.TagRepository
+}
+
+func NewExportService(transactionRepo repositories.TransactionRepository, categoryRepo repositories.CategoryRepository, accountRepo repositories.AccountRepository, tagRepo repositories.TagRepository) interfaces.ExportService {
+	return &exportServiceImpl{
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		accountRepo:     accountRepo,
+		tagRepo:         tagRepo,
+	}
+}
+
+// exportRow é uma linha da planilha; transações divididas geram uma linha por categoria
+type exportRow struct {
+	ID          uint
+	Date        time.Time
+	Description string
+	Type        string
+	Category    string
+	Account     string
+	Tags        string
+	Amount      float64
+	Paid        bool
+	Memo        string
+}
+
+// exportNames resolve os IDs das transações para os nomes exibidos no arquivo
+type exportNames struct {
+	categories map[uint]string
+	accounts   map[uint]*entities.Account
+	tags       map[uint]string
+}
+
+func (s *exportServiceImpl) ExportTransactions(ctx context.Context, userID uint, filters *repositories.TransactionFilters, format entities.ExportFormat, w io.Writer) error {
+	if !format.IsValid() {
+		return pkgErrors.NewDomainError("validation_error", "Formato de exportação inválido")
+	}
+
+	names, err := s.loadNames(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case entities.EXPORT_XLSX:
+		return s.exportXLSX(ctx, userID, filters, names, w)
+	case entities.EXPORT_OFX:
+		return s.exportOFX(ctx, userID, filters, names, w)
+	default:
+		return s.exportCSV(ctx, userID, filters, names, w)
+	}
+}
+
+// exportCSV usa o padrão das planilhas em português: ";" como separador, vírgula
+// decimal e datas DD/MM/AAAA, com BOM para o Excel reconhecer o UTF-8
+func (s *exportServiceImpl) exportCSV(ctx context.Context, userID uint, filters *repositories.TransactionFilters, names *exportNames, w io.Writer) error {
+	buffered := bufio.NewWriter(w)
+	if _, err := buffered.WriteString("\ufeff"); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(buffered)
+	writer.Comma = ';'
+
+	if err := writer.Write(exportColumns); err != nil {
+		return err
+	}
+
+	err := s.transactionRepo.StreamByUserID(ctx, userID, filters, exportBatchSize, func(transactions []*entities.Transaction) error {
+		for _, transaction := range transactions {
+			for _, row := range names.rows(transaction) {
+				record := []string{
+					row.Date.Format("02/01/2006"),
+					csvText(row.Description),
+					row.Type,
+					csvText(row.Category),
+					csvText(row.Account),
+					csvText(row.Tags),
+					strings.Replace(strconv.FormatFloat(row.Amount, 'f', 2, 64), ".", ",", 1),
+					yesNo(row.Paid),
+					csvText(row.Memo),
+					strconv.FormatUint(uint64(row.ID), 10),
+				}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Envia o lote ao cliente antes de buscar o próximo
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+		return buffered.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	return buffered.Flush()
+}
+
+// exportXLSX grava as linhas na aba "Transações" e os totais do período na aba "Resumo"
+func (s *exportServiceImpl) exportXLSX(ctx context.Context, userID uint, filters *repositories.TransactionFilters, names *exportNames, w io.Writer) error {
+	writer := xlsx.NewWriter(w)
+
+	if err := writer.AddSheet("Transações", 12, 40, 14, 22, 22, 22, 14, 8, 30, 10); err != nil {
+		return err
+	}
+
+	header := make([]interface{}, len(exportColumns))
+	for i, column := range exportColumns {
+		header[i] = xlsx.Bold(column)
+	}
+	if err := writer.WriteRow(header...); err != nil {
+		return err
+	}
+
+	summary := newExportSummary()
+
+	err := s.transactionRepo.StreamByUserID(ctx, userID, filters, exportBatchSize, func(transactions []*entities.Transaction) error {
+		for _, transaction := range transactions {
+			summary.addTransaction(transaction)
+
+			for _, row := range names.rows(transaction) {
+				summary.addRow(transaction, row)
+
+				if err := writer.WriteRow(row.Date, row.Description, row.Type, row.Category, row.Account, row.Tags,
+					xlsx.Money(row.Amount), yesNo(row.Paid), row.Memo, row.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := summary.write(writer); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// exportOFX gera um extrato por conta; transações sem conta ficam em um extrato à parte
+func (s *exportServiceImpl) exportOFX(ctx context.Context, userID uint, filters *repositories.TransactionFilters, names *exportNames, w io.Writer) error {
+	balances, err := s.accountRepo.GetBalances(ctx, userID, nil)
+	if err != nil {
+		return err
+	}
+
+	statements := make(map[uint]*ofx.Statement)
+	var order []uint
+
+	err = s.transactionRepo.StreamByUserID(ctx, userID, filters, exportBatchSize, func(transactions []*entities.Transaction) error {
+		for _, transaction := range transactions {
+			var accountID uint
+			if transaction.AccountID != nil {
+				accountID = *transaction.AccountID
+			}
+
+			statement, ok := statements[accountID]
+			if !ok {
+				statement = names.statement(accountID, balances)
+				statements[accountID] = statement
+				order = append(order, accountID)
+			}
+
+			statement.Transactions = append(statement.Transactions, ofx.Transaction{
+				FITID:  strconv.FormatUint(uint64(transaction.ID), 10),
+				Type:   ofxTransactionType(transaction),
+				Date:   transaction.Date,
+				Amount: transaction.SignedAmount(),
+				Name:   transaction.Description,
+				Memo:   names.categoryList(transaction),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	result := make([]ofx.Statement, 0, len(order))
+	for _, accountID := range order {
+		result = append(result, *statements[accountID])
+	}
+
+	return ofx.Write(w, result, time.Now())
+}
+
+func (s *exportServiceImpl) loadNames(ctx context.Context, userID uint) (*exportNames, error) {
+	names := &exportNames{
+		categories: make(map[uint]string),
+		accounts:   make(map[uint]*entities.Account),
+		tags:       make(map[uint]string),
+	}
+
+	categories, err := s.categoryRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range categories {
+		names.categories[category.ID] = category.Name
+	}
+
+	// Contas arquivadas continuam aparecendo nas transações antigas
+	accounts, err := s.accountRepo.GetByUserID(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		names.accounts[account.ID] = account
+	}
+
+	tags, err := s.tagRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		names.tags[tag.ID] = tag.Name
+	}
+
+	return names, nil
+}
+
+// rows converte a transação em linhas da planilha, com o valor já com sinal
+func (n *exportNames) rows(transaction *entities.Transaction) []exportRow {
+	base := exportRow{
+		ID:          transaction.ID,
+		Date:        transaction.Date,
+		Description: transaction.Description,
+		Type:        exportTypeLabels[transaction.Type],
+		Category:    n.category(transaction.CategoryID),
+		Tags:        n.tagList(transaction.TagIDs),
+		Amount:      transaction.SignedAmount(),
+		Paid:        transaction.Paid,
+	}
+	if transaction.AccountID != nil {
+		if account, ok := n.accounts[*transaction.AccountID]; ok {
+			base.Account = account.Name
+		}
+	}
+
+	if !transaction.HasSplits() {
+		return []exportRow{base}
+	}
+
+	sign := 1.0
+	if base.Amount < 0 {
+		sign = -1
+	}
+
+	rows := make([]exportRow, len(transaction.Splits))
+	for i, split := range transaction.Splits {
+		categoryID := split.CategoryID
+		rows[i] = base
+		rows[i].Category = n.category(&categoryID)
+		rows[i].Amount = sign * split.Amount
+		rows[i].Memo = split.Memo
+	}
+	return rows
+}
+
+func (n *exportNames) category(categoryID *uint) string {
+	if categoryID == nil {
+		return ""
+	}
+	return n.categories[*categoryID]
+}
+
+// categoryList junta as categorias da transação, inclusive as das linhas da divisão
+func (n *exportNames) categoryList(transaction *entities.Transaction) string {
+	if !transaction.HasSplits() {
+		return n.category(transaction.CategoryID)
+	}
+
+	seen := make(map[string]bool)
+	var categories []string
+	for _, split := range transaction.Splits {
+		categoryID := split.CategoryID
+		if name := n.category(&categoryID); name != "" && !seen[name] {
+			seen[name] = true
+			categories = append(categories, name)
+		}
+	}
+	return strings.Join(categories, ", ")
+}
+
+func (n *exportNames) tagList(tagIDs []uint) string {
+	tags := make([]string, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if name, ok := n.tags[tagID]; ok {
+			tags = append(tags, name)
+		}
+	}
+	sort.Strings(tags)
+	return strings.Join(tags, ", ")
+}
+
+// statement monta o cabeçalho do extrato OFX da conta (0 = transações sem conta)
+func (n *exportNames) statement(accountID uint, balances []repositories.AccountBalance) *ofx.Statement {
+	statement := &ofx.Statement{
+		BankID:      "0000",
+		AccountID:   "SEM-CONTA",
+		AccountType: "CHECKING",
+		Currency:    entities.DefaultCurrency,
+	}
+
+	account, ok := n.accounts[accountID]
+	if !ok {
+		return statement
+	}
+
+	statement.AccountID = strconv.FormatUint(uint64(account.ID), 10)
+	statement.Currency = account.Currency
+	switch account.Kind {
+	case entities.CREDIT_CARD:
+		statement.CreditCard = true
+		statement.AccountType = ""
+	case entities.SAVINGS:
+		statement.AccountType = "SAVINGS"
+	}
+
+	for _, balance := range balances {
+		if balance.AccountID == account.ID {
+			value := balance.Balance
+			statement.Balance = &value
+			break
+		}
+	}
+
+	return statement
+}
+
+func ofxTransactionType(transaction *entities.Transaction) string {
+	switch {
+	case transaction.IsTransfer():
+		return "XFER"
+	case transaction.SignedAmount() < 0:
+		return "DEBIT"
+	default:
+		return "CREDIT"
+	}
+}
+
+// csvText prefixa com ' os textos que uma planilha interpretaria como fórmula
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "Sim"
+	}
+	return "Não"
+}
+
+// exportSummary acumula os totais exibidos na aba "Resumo" da planilha
+type exportSummary struct {
+	count      int
+	first      time.Time
+	last       time.Time
+	byType     map[entities.TransactionType]float64
+	byCategory map[[2]string]float64
+}
+
+func newExportSummary() *exportSummary {
+	return &exportSummary{
+		byType:     make(map[entities.TransactionType]float64),
+		byCategory: make(map[[2]string]float64),
+	}
+}
+
+func (s *exportSummary) addTransaction(transaction *entities.Transaction) {
+	if s.count == 0 || transaction.Date.Before(s.first) {
+		s.first = transaction.Date
+	}
+	if s.count == 0 || transaction.Date.After(s.last) {
+		s.last = transaction.Date
+	}
+	s.count++
+
+	// Transferências apenas movem dinheiro entre contas e ficam fora dos totais
+	if !transaction.IsTransfer() {
+		s.byType[transaction.Type] += transaction.Amount
+	}
+}
+
+func (s *exportSummary) addRow(transaction *entities.Transaction, row exportRow) {
+	if transaction.IsTransfer() {
+		return
+	}
+
+	category := row.Category
+	if category == "" {
+		category = "Sem categoria"
+	}
+	s.byCategory[[2]string{category, row.Type}] += math.Abs(row.Amount)
+}
+
+func (s *exportSummary) write(writer *xlsx.Writer) error {
+	if err := writer.AddSheet("Resumo", 28, 16, 16); err != nil {
+		return err
+	}
+
+	income := s.byType[entities.INCOME]
+	expense := s.byType[entities.EXPENSE]
+	investment := s.byType[entities.INVESTMENT]
+
+	rows := [][]interface{}{
+		{xlsx.Bold("Resumo da exportação")},
+		{"Gerado em", time.Now()},
+		{"Transações", s.count},
+	}
+	if s.count > 0 {
+		rows = append(rows, []interface{}{"Período", s.first, s.last})
+	}
+	rows = append(rows,
+		nil,
+		[]interface{}{"Receitas", xlsx.Money(income)},
+		[]interface{}{"Despesas", xlsx.Money(expense)},
+		[]interface{}{"Investimentos", xlsx.Money(investment)},
+		[]interface{}{xlsx.Bold("Resultado"), xlsx.Money(income - expense - investment)},
+		nil,
+		[]interface{}{xlsx.Bold("Categoria"), xlsx.Bold("Tipo"), xlsx.Bold("Total")},
+	)
+
+	for _, row := range rows {
+		if err := writer.WriteRow(row...); err != nil {
+			return err
+		}
+	}
+
+	keys := make([][2]string, 0, len(s.byCategory))
+	for key := range s.byCategory {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if s.byCategory[keys[i]] != s.byCategory[keys[j]] {
+			return s.byCategory[keys[i]] > s.byCategory[keys[j]]
+		}
+		return keys[i][0] < keys[j][0]
+	})
+
+	for _, key := range keys {
+		if err := writer.WriteRow(key[0], key[1], xlsx.Money(s.byCategory[key])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
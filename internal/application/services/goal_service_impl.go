@@ -6,15 +6,24 @@ import (
 	"my-finance-hub-api/internal/domain/entities"
 	"my-finance-hub-api/internal/domain/repositories"
 	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
 )
 
 type goalServiceImpl struct {
-	goalRepo repositories.GoalRepository
+	goalRepo        repositories.GoalRepository
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+	tagRepo         repositories.TagRepository
+	accountRepo     repositories.AccountRepository
 }
 
-func NewGoalService(goalRepo repositories.GoalRepository) interfaces.GoalService {
+func NewGoalService(goalRepo repositories.GoalRepository, transactionRepo repositories.TransactionRepository, categoryRepo repositories.CategoryRepository, tagRepo repositories.TagRepository, accountRepo repositories.AccountRepository) interfaces.GoalService {
 	return &goalServiceImpl{
-		goalRepo: goalRepo,
+		goalRepo:        goalRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		tagRepo:         tagRepo,
+		accountRepo:     accountRepo,
 	}
 }
 
@@ -30,6 +39,9 @@ func (s *goalServiceImpl) CreateGoal(ctx context.Context, userID uint, goal *ent
 
 	// Criar nova meta
 	newGoal := entities.NewGoal(goal.Name, goal.Description, goal.Amount, userID)
+	if err := s.applyTracking(ctx, userID, newGoal, goal); err != nil {
+		return nil, err
+	}
 
 	if err := s.goalRepo.Create(ctx, newGoal); err != nil {
 		return nil, err
@@ -74,6 +86,9 @@ func (s *goalServiceImpl) UpdateGoal(ctx context.Context, userID, goalID uint, u
 
 	// Atualizar meta
 	goal.Update(updates.Name, updates.Description, updates.Amount)
+	if err := s.applyTracking(ctx, userID, goal, updates); err != nil {
+		return nil, err
+	}
 
 	if err := s.goalRepo.Update(ctx, goal); err != nil {
 		return nil, err
@@ -92,3 +107,146 @@ func (s *goalServiceImpl) DeleteGoal(ctx context.Context, userID, goalID uint) e
 	// Excluir meta
 	return s.goalRepo.Delete(ctx, goalID)
 }
+
+func (s *goalServiceImpl) GetGoalProgress(ctx context.Context, userID, goalID uint) (*entities.GoalProgress, error) {
+	goal, err := s.GetGoalByID(ctx, userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.computeProgress(ctx, userID, []*entities.Goal{goal})
+	if err != nil {
+		return nil, err
+	}
+
+	return progress[0], nil
+}
+
+func (s *goalServiceImpl) GetGoalsProgress(ctx context.Context, userID uint) ([]*entities.GoalProgress, error) {
+	goals, err := s.goalRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.computeProgress(ctx, userID, goals)
+}
+
+// computeProgress busca uma única vez as transações do período que cobre todas as
+// metas; cada meta considera apenas as do seu próprio período
+func (s *goalServiceImpl) computeProgress(ctx context.Context, userID uint, goals []*entities.Goal) ([]*entities.GoalProgress, error) {
+	result := make([]*entities.GoalProgress, len(goals))
+	if len(goals) == 0 {
+		return result, nil
+	}
+
+	now := time.Now()
+	start := goals[0].StartDate
+	end := goals[0].PeriodEnd(now)
+	for _, goal := range goals[1:] {
+		if goal.StartDate.Before(start) {
+			start = goal.StartDate
+		}
+		if goalEnd := goal.PeriodEnd(now); goalEnd.After(end) {
+			end = goalEnd
+		}
+	}
+
+	transactions, err := s.transactionRepo.GetByDateRange(ctx, userID, start.UTC().Truncate(24*time.Hour), end)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, goal := range goals {
+		result[i] = goal.Progress(transactions, now)
+	}
+
+	return result, nil
+}
+
+// applyTracking valida e aplica à meta o tipo, o período e os vínculos informados.
+// Tipo, data de início e listas de vínculos não informados (nil) mantêm os valores
+// da meta; a data alvo é sempre substituída.
+func (s *goalServiceImpl) applyTracking(ctx context.Context, userID uint, goal, tracking *entities.Goal) error {
+	kind := tracking.Kind
+	if kind == "" {
+		kind = goal.Kind
+	}
+	if !kind.IsValid() {
+		return pkgErrors.NewDomainError("validation_error", "Tipo de meta inválido")
+	}
+
+	startDate := tracking.StartDate
+	if startDate.IsZero() {
+		startDate = goal.StartDate
+	}
+
+	if tracking.TargetDate != nil && tracking.TargetDate.Before(startDate.Truncate(24*time.Hour)) {
+		return pkgErrors.NewDomainError("validation_error", "Data alvo deve ser posterior à data de início")
+	}
+
+	categoryIDs, tagIDs, accountIDs := tracking.CategoryIDs, tracking.TagIDs, tracking.AccountIDs
+	if categoryIDs == nil {
+		categoryIDs = goal.CategoryIDs
+	}
+	if tagIDs == nil {
+		tagIDs = goal.TagIDs
+	}
+	if accountIDs == nil {
+		accountIDs = goal.AccountIDs
+	}
+
+	if err := s.validateLinks(ctx, userID, categoryIDs, tagIDs, accountIDs); err != nil {
+		return err
+	}
+
+	goal.UpdateTracking(kind, startDate, tracking.TargetDate, categoryIDs, tagIDs, accountIDs)
+	return nil
+}
+
+// validateLinks verifica se as categorias, tags e contas vinculadas pertencem ao usuário
+func (s *goalServiceImpl) validateLinks(ctx context.Context, userID uint, categoryIDs, tagIDs, accountIDs []uint) error {
+	for _, categoryID := range categoryIDs {
+		category, err := s.categoryRepo.GetByID(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+		if !category.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+	}
+
+	for _, accountID := range accountIDs {
+		account, err := s.accountRepo.GetByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if !account.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+	}
+
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	tags, err := s.tagRepo.GetByIDs(ctx, tagIDs)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[uint]bool, len(tags))
+	for _, tag := range tags {
+		if !tag.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+		found[tag.ID] = true
+	}
+
+	for _, id := range tagIDs {
+		if !found[id] {
+			return pkgErrors.ErrTagNotFound
+		}
+	}
+
+	return nil
+}
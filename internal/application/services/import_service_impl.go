@@ -0,0 +1,609 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/pkg/charset"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"my-finance-hub-api/pkg/ofx"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxImportFileSize limita o tamanho dos arquivos de extrato aceitos
+const maxImportFileSize = 5 << 20
+
+type importServiceImpl struct {
+	transactionRepo    repositories.TransactionRepository
+	accountRepo        repositories.AccountRepository
+	categoryRepo       repositories.CategoryRepository
+	profileRepo        repositories.ImportProfileRepository
+	transactionService interfaces.TransactionService
+	ruleService        interfaces.RuleService
+	payeeService       interfaces.PayeeService
+}
+
+func NewImportService(transactionRepo repositories.TransactionRepository, accountRepo repositories.AccountRepository, categoryRepo repositories.CategoryRepository, profileRepo repositories.ImportProfileRepository, transactionService interfaces.TransactionService, ruleService interfaces.RuleService, payeeService interfaces.PayeeService) interfaces.ImportService {
+	return &importServiceImpl{
+		transactionRepo:    transactionRepo,
+		accountRepo:        accountRepo,
+		categoryRepo:       categoryRepo,
+		profileRepo:        profileRepo,
+		transactionService: transactionService,
+		ruleService:        ruleService,
+		payeeService:       payeeService,
+	}
+}
+
+func (s *importServiceImpl) ImportOFX(ctx context.Context, userID uint, accountID *uint, content io.Reader, commit bool) (*entities.ImportResult, error) {
+	if err := s.validateAccount(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+
+	data, err := readImportFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := ofx.Parse(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, ofx.ErrInvalidFile) {
+			return nil, pkgErrors.NewDomainErrorWithDetails("validation_error", "Arquivo OFX inválido", err.Error())
+		}
+		return nil, err
+	}
+
+	var items []*entities.ImportItem
+	for _, statement := range statements {
+		for _, line := range statement.Transactions {
+			// Lançamentos sem valor (ex.: saldo informativo) não viram transações
+			if line.Amount == 0 {
+				continue
+			}
+
+			transactionType := entities.INCOME
+			if line.Amount < 0 {
+				transactionType = entities.EXPENSE
+			}
+
+			transaction := entities.NewTransaction(ofxDescription(line), math.Abs(line.Amount), transactionType, line.Date, userID)
+			transaction.Paid = true
+			transaction.ExternalID = ofxExternalID(statement, line)
+			if accountID != nil {
+				transaction.SetAccount(*accountID)
+			}
+
+			items = append(items, &entities.ImportItem{Transaction: transaction})
+		}
+	}
+
+	return s.importItems(ctx, userID, items, commit)
+}
+
+func (s *importServiceImpl) ImportCSV(ctx context.Context, userID, profileID uint, accountID *uint, content io.Reader, commit bool) (*entities.ImportResult, error) {
+	profile, err := s.GetProfileByID(ctx, userID, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountID == nil {
+		accountID = profile.AccountID
+	}
+	if err := s.validateAccount(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+
+	data, err := readImportFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryIndex(ctx, userID, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(strings.NewReader(charset.ToUTF8(data, profile.Encoding)))
+	reader.Comma = profile.DelimiterRune()
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+
+	var items []*entities.ImportItem
+	// Linhas idênticas no mesmo arquivo são lançamentos distintos (ex.: duas passagens
+	// de ônibus no mesmo dia); o ordinal as diferencia no ExternalID
+	occurrences := make(map[string]int)
+	record := 0
+
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, pkgErrors.NewDomainErrorWithDetails("validation_error", "Arquivo CSV inválido", err.Error())
+		}
+
+		record++
+		if record <= profile.SkipRows || (profile.HasHeader && record == profile.SkipRows+1) || isBlankRecord(fields) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		item := s.parseCSVRecord(userID, profile, fields, categories)
+		item.Line = line
+
+		if item.IsValid() {
+			transaction := item.Transaction
+			if accountID != nil {
+				transaction.SetAccount(*accountID)
+			}
+
+			key := csvRowKey(accountID, transaction)
+			occurrences[key]++
+			transaction.ExternalID = csvExternalID(key, occurrences[key])
+		}
+
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Nenhuma linha encontrada no arquivo")
+	}
+
+	return s.importItems(ctx, userID, items, commit)
+}
+
+// parseCSVRecord converte uma linha do CSV; problemas de formato viram erro da linha
+func (s *importServiceImpl) parseCSVRecord(userID uint, profile *entities.ImportProfile, fields []string, categories map[string]uint) *entities.ImportItem {
+	item := &entities.ImportItem{}
+
+	column := func(index int) (string, error) {
+		if index >= len(fields) {
+			return "", fmt.Errorf("coluna %d ausente na linha", index+1)
+		}
+		return strings.TrimSpace(fields[index]), nil
+	}
+
+	value, err := column(profile.DateColumn)
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+	date, err := profile.ParseDate(value)
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	description, err := column(profile.DescriptionColumn)
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+	if description == "" {
+		item.Error = "descrição vazia"
+		return item
+	}
+
+	amount, err := csvAmount(profile, column)
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+	if amount == 0 {
+		item.Error = "valor zerado"
+		return item
+	}
+
+	transactionType := entities.INCOME
+	if amount < 0 {
+		transactionType = entities.EXPENSE
+	}
+
+	transaction := entities.NewTransaction(truncate(description, 255), math.Abs(amount), transactionType, date, userID)
+	transaction.Paid = true
+	item.Transaction = transaction
+
+	// Categoria desconhecida não invalida a linha, que é importada sem categoria
+	if profile.CategoryColumn != nil {
+		if name, _ := column(*profile.CategoryColumn); name != "" {
+			if categoryID, ok := categories[strings.ToLower(name)]; ok {
+				transaction.SetCategory(categoryID)
+			} else {
+				item.Warning = fmt.Sprintf("categoria %q não encontrada; linha importada sem categoria", name)
+			}
+		}
+	}
+
+	return item
+}
+
+// csvAmount lê o valor da coluna única ou combina as colunas de débito e crédito.
+// Débitos são sempre saída, independentemente do sinal usado pelo banco.
+func csvAmount(profile *entities.ImportProfile, column func(int) (string, error)) (float64, error) {
+	if profile.AmountColumn != nil {
+		value, err := column(*profile.AmountColumn)
+		if err != nil {
+			return 0, err
+		}
+		amount, err := profile.ParseAmount(value)
+		if err != nil {
+			return 0, err
+		}
+		if profile.InvertAmount {
+			amount = -amount
+		}
+		return amount, nil
+	}
+
+	var amount float64
+	for _, mapping := range []struct {
+		index *int
+		sign  float64
+	}{{profile.DebitColumn, -1}, {profile.CreditColumn, 1}} {
+		if mapping.index == nil {
+			continue
+		}
+		value, err := column(*mapping.index)
+		if err != nil || value == "" {
+			continue
+		}
+		parsed, err := profile.ParseAmount(value)
+		if err != nil {
+			return 0, err
+		}
+		amount += mapping.sign * math.Abs(parsed)
+	}
+
+	return amount, nil
+}
+
+// categoryIndex mapeia o nome das categorias do usuário (sem diferenciar maiúsculas) para o ID
+func (s *importServiceImpl) categoryIndex(ctx context.Context, userID uint, profile *entities.ImportProfile) (map[string]uint, error) {
+	index := make(map[string]uint)
+	if profile.CategoryColumn == nil {
+		return index, nil
+	}
+
+	categories, err := s.categoryRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, category := range categories {
+		index[strings.ToLower(strings.TrimSpace(category.Name))] = category.ID
+	}
+
+	return index, nil
+}
+
+func (s *importServiceImpl) CreateProfile(ctx context.Context, userID uint, profile *entities.ImportProfile) (*entities.ImportProfile, error) {
+	if err := s.validateProfile(ctx, userID, profile); err != nil {
+		return nil, err
+	}
+
+	// Verificar se já existe um perfil com o mesmo nome para o usuário
+	exists, err := s.profileRepo.ExistsByName(ctx, userID, profile.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, pkgErrors.NewDomainError("already_exists", "Já existe um perfil de importação com este nome")
+	}
+
+	newProfile := entities.NewImportProfile(profile.Name, userID)
+	newProfile.Update(profile)
+
+	if err := s.profileRepo.Create(ctx, newProfile); err != nil {
+		return nil, err
+	}
+
+	return newProfile, nil
+}
+
+func (s *importServiceImpl) GetProfileByID(ctx context.Context, userID, profileID uint) (*entities.ImportProfile, error) {
+	profile, err := s.profileRepo.GetByID(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se o perfil pertence ao usuário
+	if !profile.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return profile, nil
+}
+
+func (s *importServiceImpl) GetProfilesByUser(ctx context.Context, userID uint) ([]*entities.ImportProfile, error) {
+	return s.profileRepo.GetByUserID(ctx, userID)
+}
+
+func (s *importServiceImpl) UpdateProfile(ctx context.Context, userID, profileID uint, updates *entities.ImportProfile) (*entities.ImportProfile, error) {
+	// Buscar perfil existente
+	profile, err := s.GetProfileByID(ctx, userID, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProfile(ctx, userID, updates); err != nil {
+		return nil, err
+	}
+
+	// Verificar se o novo nome já existe (se foi alterado)
+	if !strings.EqualFold(strings.TrimSpace(updates.Name), profile.Name) {
+		exists, err := s.profileRepo.ExistsByName(ctx, userID, updates.Name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, pkgErrors.NewDomainError("already_exists", "Já existe um perfil de importação com este nome")
+		}
+	}
+
+	profile.Update(updates)
+
+	if err := s.profileRepo.Update(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (s *importServiceImpl) DeleteProfile(ctx context.Context, userID, profileID uint) error {
+	// Verificar se o perfil existe e pertence ao usuário
+	if _, err := s.GetProfileByID(ctx, userID, profileID); err != nil {
+		return err
+	}
+
+	return s.profileRepo.Delete(ctx, profileID)
+}
+
+func (s *importServiceImpl) validateProfile(ctx context.Context, userID uint, profile *entities.ImportProfile) error {
+	if strings.TrimSpace(profile.Name) == "" {
+		return pkgErrors.NewDomainError("validation_error", "Nome do perfil é obrigatório")
+	}
+
+	if profile.Delimiter != `\t` && (utf8.RuneCountInString(profile.Delimiter) != 1 || strings.ContainsAny(profile.Delimiter, "\"\r\n")) {
+		return pkgErrors.NewDomainError("validation_error", "Separador deve ser um único caractere")
+	}
+
+	if profile.DecimalSeparator != "," && profile.DecimalSeparator != "." {
+		return pkgErrors.NewDomainError("validation_error", "Separador decimal deve ser ',' ou '.'")
+	}
+
+	if _, err := profile.DateLayout(); err != nil {
+		return pkgErrors.NewDomainErrorWithDetails("validation_error", "Formato de data inválido", "use DD, MM e YYYY (ou YY) separados por '/', '-' ou '.'")
+	}
+
+	if !charset.IsSupported(profile.Encoding) {
+		return pkgErrors.NewDomainErrorWithDetails("validation_error", "Codificação não suportada", "use auto, utf-8 ou iso-8859-1")
+	}
+
+	if profile.SkipRows < 0 {
+		return pkgErrors.NewDomainError("validation_error", "Quantidade de linhas ignoradas não pode ser negativa")
+	}
+
+	hasDebitCredit := profile.DebitColumn != nil || profile.CreditColumn != nil
+	if (profile.AmountColumn == nil) == !hasDebitCredit {
+		return pkgErrors.NewDomainError("validation_error", "Informe a coluna de valor ou as colunas de débito/crédito")
+	}
+
+	for _, index := range []*int{&profile.DateColumn, &profile.DescriptionColumn, profile.AmountColumn, profile.DebitColumn, profile.CreditColumn, profile.CategoryColumn} {
+		if index != nil && *index < 0 {
+			return pkgErrors.NewDomainError("validation_error", "Índices de coluna não podem ser negativos")
+		}
+	}
+
+	return s.validateAccount(ctx, userID, profile.AccountID)
+}
+
+// importItems marca as linhas já importadas e, quando commit for verdadeiro,
+// cria as demais. Linhas com erro são apenas contabilizadas. Uma falha no meio
+// interrompe a importação; como as linhas gravadas ficam registradas pelo
+// ExternalID, reenviar o arquivo importa apenas o restante.
+func (s *importServiceImpl) importItems(ctx context.Context, userID uint, items []*entities.ImportItem, commit bool) (*entities.ImportResult, error) {
+	externalIDs := make([]string, 0, len(items))
+	transactions := make([]*entities.Transaction, 0, len(items))
+	for _, item := range items {
+		if item.IsValid() {
+			externalIDs = append(externalIDs, item.Transaction.ExternalID)
+			transactions = append(transactions, item.Transaction)
+		}
+	}
+
+	// Aplicar regras e favorecidos já na prévia, para que apareçam antes da confirmação
+	if err := s.ruleService.ApplyActiveRules(ctx, userID, transactions); err != nil {
+		return nil, err
+	}
+	if err := s.payeeService.AssignPayees(ctx, userID, transactions); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.transactionRepo.GetExistingExternalIDs(ctx, userID, externalIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.flagDuplicateCandidates(ctx, userID, items, existing); err != nil {
+		return nil, err
+	}
+
+	result := &entities.ImportResult{
+		Committed: commit,
+		Items:     items,
+	}
+
+	for _, item := range items {
+		if !item.IsValid() {
+			result.Invalid++
+			continue
+		}
+
+		transaction := item.Transaction
+
+		// Linhas repetidas no próprio arquivo também são ignoradas
+		if existing[transaction.ExternalID] {
+			item.Duplicate = true
+			result.Duplicates++
+		}
+		existing[transaction.ExternalID] = true
+
+		if commit && !item.Duplicate {
+			created, err := s.transactionService.CreateTransaction(ctx, userID, transaction)
+			if err != nil {
+				return nil, err
+			}
+			item.Transaction = created
+			result.Imported++
+		}
+	}
+
+	return result, nil
+}
+
+// flagDuplicateCandidates sinaliza as linhas novas que se parecem com transações já
+// gravadas sem o mesmo identificador externo
+func (s *importServiceImpl) flagDuplicateCandidates(ctx context.Context, userID uint, items []*entities.ImportItem, existing map[string]bool) error {
+	var pending []*entities.ImportItem
+	var transactions []*entities.Transaction
+	for _, item := range items {
+		if item.IsValid() && !existing[item.Transaction.ExternalID] {
+			pending = append(pending, item)
+			transactions = append(transactions, item.Transaction)
+		}
+	}
+
+	candidates, err := s.transactionService.FindDuplicateCandidates(ctx, userID, transactions)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range pending {
+		if len(candidates[i]) == 0 {
+			continue
+		}
+		item.DuplicateCandidates = candidates[i]
+		item.AddWarning(entities.DuplicateWarning(candidates[i]))
+	}
+
+	return nil
+}
+
+// validateAccount garante que a conta de destino exista, pertença ao usuário e não esteja arquivada
+func (s *importServiceImpl) validateAccount(ctx context.Context, userID uint, accountID *uint) error {
+	if accountID == nil {
+		return nil
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, *accountID)
+	if err != nil {
+		return err
+	}
+
+	if !account.BelongsToUser(userID) {
+		return pkgErrors.ErrForbidden
+	}
+
+	if account.Archived {
+		return pkgErrors.NewDomainError("validation_error", "Conta arquivada não aceita novas transações")
+	}
+
+	return nil
+}
+
+func readImportFile(content io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(content, maxImportFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Arquivo vazio")
+	}
+
+	if len(data) > maxImportFileSize {
+		return nil, pkgErrors.NewDomainErrorWithDetails("validation_error", "Arquivo excede o tamanho máximo permitido",
+			fmt.Sprintf("tamanho máximo: %d MB", maxImportFileSize>>20))
+	}
+
+	return data, nil
+}
+
+// ofxExternalID identifica a linha pela conta do extrato e pelo FITID, que o banco
+// garante ser único apenas dentro da mesma conta
+func ofxExternalID(statement ofx.Statement, line ofx.Transaction) string {
+	parts := []string{"ofx"}
+	for _, part := range []string{statement.BankID, statement.AccountID, line.FITID} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return truncate(strings.Join(parts, ":"), 255)
+}
+
+// ofxDescription usa NAME e MEMO; bancos brasileiros costumam preencher apenas o MEMO
+func ofxDescription(line ofx.Transaction) string {
+	name, memo := strings.TrimSpace(line.Name), strings.TrimSpace(line.Memo)
+
+	description := name
+	switch {
+	case name == "":
+		description = memo
+	case memo != "" && !strings.EqualFold(name, memo):
+		description = name + " - " + memo
+	}
+
+	if description == "" {
+		description = "Lançamento " + line.FITID
+	}
+
+	return truncate(description, 255)
+}
+
+// isBlankRecord identifica linhas sem conteúdo, comuns no fim dos extratos
+func isBlankRecord(fields []string) bool {
+	for _, field := range fields {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// csvRowKey identifica a linha pelo conteúdo, já que o CSV não traz um ID do banco
+func csvRowKey(accountID *uint, transaction *entities.Transaction) string {
+	var account uint
+	if accountID != nil {
+		account = *accountID
+	}
+
+	amount := transaction.Amount
+	if transaction.Type == entities.EXPENSE {
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%d|%s|%.2f|%s", account, transaction.Date.Format("2006-01-02"), amount, strings.ToLower(transaction.Description))
+}
+
+func csvExternalID(key string, occurrence int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, occurrence)))
+	return "csv:" + hex.EncodeToString(sum[:16])
+}
+
+func truncate(value string, limit int) string {
+	if runes := []rune(value); len(runes) > limit {
+		return string(runes[:limit])
+	}
+	return value
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+)
+
+type invoiceServiceImpl struct {
+	invoiceRepo     repositories.InvoiceRepository
+	accountRepo     repositories.AccountRepository
+	transactionRepo repositories.TransactionRepository
+}
+
+func NewInvoiceService(invoiceRepo repositories.InvoiceRepository, accountRepo repositories.AccountRepository, transactionRepo repositories.TransactionRepository) interfaces.InvoiceService {
+	return &invoiceServiceImpl{
+		invoiceRepo:     invoiceRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func (s *invoiceServiceImpl) GetInvoicesByAccount(ctx context.Context, userID, accountID uint, status *entities.InvoiceStatus) ([]*entities.Invoice, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se a conta pertence ao usuário
+	if !account.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	if !account.IsCreditCard() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Conta não é um cartão de crédito")
+	}
+
+	invoices, err := s.invoiceRepo.GetByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == nil {
+		return invoices, nil
+	}
+
+	now := time.Now()
+	filtered := make([]*entities.Invoice, 0, len(invoices))
+	for _, invoice := range invoices {
+		if invoice.Status(now) == *status {
+			filtered = append(filtered, invoice)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (s *invoiceServiceImpl) GetInvoiceByID(ctx context.Context, userID, invoiceID uint) (*entities.Invoice, error) {
+	invoice, err := s.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se a fatura pertence ao usuário
+	if !invoice.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return invoice, nil
+}
+
+func (s *invoiceServiceImpl) GetInvoiceItems(ctx context.Context, userID, invoiceID uint) ([]*entities.Transaction, error) {
+	if _, err := s.GetInvoiceByID(ctx, userID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	return s.transactionRepo.GetByInvoiceID(ctx, invoiceID)
+}
+
+func (s *invoiceServiceImpl) PayInvoice(ctx context.Context, userID, invoiceID, fromAccountID uint, date time.Time) (*entities.Invoice, error) {
+	invoice, err := s.GetInvoiceByID(ctx, userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validações
+	if invoice.IsPaid() {
+		return nil, pkgErrors.ErrInvoiceAlreadyPaid
+	}
+
+	// Enquanto aberta a fatura ainda recebe lançamentos
+	if invoice.Status(time.Now()) == entities.INVOICE_OPEN {
+		return nil, pkgErrors.NewDomainError("validation_error", "Fatura ainda está aberta")
+	}
+
+	if invoice.Total <= 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Fatura não possui valor a pagar")
+	}
+
+	if fromAccountID == invoice.AccountID {
+		return nil, pkgErrors.NewDomainError("validation_error", "Conta de origem e destino devem ser diferentes")
+	}
+
+	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fromAccount.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	if fromAccount.Archived {
+		return nil, pkgErrors.NewDomainError("validation_error", "Conta arquivada não aceita novas transações")
+	}
+
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	// Pagamento é uma transferência da conta escolhida para o cartão, gravada junto
+	// com a baixa da fatura; o valor é recalculado pelo repositório na mesma transação
+	debit, credit := entities.NewTransferPair(fmt.Sprintf("Pagamento fatura %02d/%d", invoice.Month, invoice.Year),
+		invoice.Total, date, userID, fromAccountID, invoice.AccountID)
+	debit.Paid = true
+	credit.Paid = true
+
+	if err := s.invoiceRepo.Pay(ctx, invoice.ID, debit, credit, date); err != nil {
+		return nil, err
+	}
+
+	invoice.Total = debit.Amount
+	invoice.PaidAt = &date
+	invoice.PaymentTransactionID = &debit.ID
+	invoice.UpdatedAt = time.Now()
+
+	return invoice, nil
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"log"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+)
+
+const (
+	defaultNotificationLimit = 50
+	maxNotificationLimit     = 100
+)
+
+type notificationServiceImpl struct {
+	notificationRepo repositories.NotificationRepository
+	transactionRepo  repositories.TransactionRepository
+	budgetService    interfaces.BudgetService
+}
+
+func NewNotificationService(notificationRepo repositories.NotificationRepository, transactionRepo repositories.TransactionRepository, budgetService interfaces.BudgetService) interfaces.NotificationService {
+	return &notificationServiceImpl{
+		notificationRepo: notificationRepo,
+		transactionRepo:  transactionRepo,
+		budgetService:    budgetService,
+	}
+}
+
+func (s *notificationServiceImpl) GetNotifications(ctx context.Context, userID uint, unreadOnly bool, limit int) ([]*entities.Notification, int64, error) {
+	if limit <= 0 {
+		limit = defaultNotificationLimit
+	}
+	if limit > maxNotificationLimit {
+		limit = maxNotificationLimit
+	}
+
+	notifications, err := s.notificationRepo.GetByUserID(ctx, userID, unreadOnly, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	unread, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, unread, nil
+}
+
+func (s *notificationServiceImpl) MarkAsRead(ctx context.Context, userID, notificationID uint) (*entities.Notification, error) {
+	notification, err := s.notificationRepo.GetByID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se a notificação pertence ao usuário
+	if !notification.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	if notification.IsRead() {
+		return notification, nil
+	}
+
+	notification.MarkRead()
+	if err := s.notificationRepo.Update(ctx, notification); err != nil {
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+func (s *notificationServiceImpl) MarkAllAsRead(ctx context.Context, userID uint) (int64, error) {
+	return s.notificationRepo.MarkAllRead(ctx, userID)
+}
+
+func (s *notificationServiceImpl) GetSettings(ctx context.Context, userID uint) (*entities.NotificationSettings, error) {
+	settings, err := s.notificationRepo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return entities.DefaultNotificationSettings(userID), nil
+	}
+	return settings, nil
+}
+
+func (s *notificationServiceImpl) UpdateSettings(ctx context.Context, userID uint, updates *entities.NotificationSettings) (*entities.NotificationSettings, error) {
+	if updates.LargeExpenseAmount < 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Limite de despesa alta não pode ser negativo")
+	}
+
+	settings, err := s.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Update(updates.BudgetThresholds, updates.LargeExpenseAmount, updates.OverdueAlerts)
+
+	if err := s.notificationRepo.SaveSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (s *notificationServiceImpl) EvaluateTransaction(ctx context.Context, userID uint, transaction *entities.Transaction) error {
+	if transaction.Type != entities.EXPENSE {
+		return nil
+	}
+
+	settings, err := s.GetSettings(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	var notifications []*entities.Notification
+
+	if settings.IsLargeExpense(transaction) {
+		notifications = append(notifications, entities.NewLargeExpenseNotification(transaction, settings.LargeExpenseAmount))
+	}
+
+	if settings.IsOverdue(transaction, startOfToday()) {
+		notifications = append(notifications, entities.NewOverdueNotification(transaction))
+	}
+
+	budgetNotifications, err := s.budgetNotifications(ctx, userID, settings, transaction)
+	if err != nil {
+		return err
+	}
+	notifications = append(notifications, budgetNotifications...)
+
+	for _, notification := range notifications {
+		if _, err := s.notificationRepo.CreateIfAbsent(ctx, notification); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *notificationServiceImpl) NotifyOverdueExpenses(ctx context.Context) error {
+	today := startOfToday()
+
+	transactions, err := s.transactionRepo.GetUnpaidExpenses(ctx, today.Add(-entities.OverdueLookback), today)
+	if err != nil {
+		return err
+	}
+
+	settingsByUser := make(map[uint]*entities.NotificationSettings)
+	created := 0
+
+	for _, transaction := range transactions {
+		settings, ok := settingsByUser[transaction.UserID]
+		if !ok {
+			if settings, err = s.GetSettings(ctx, transaction.UserID); err != nil {
+				return err
+			}
+			settingsByUser[transaction.UserID] = settings
+		}
+
+		if !settings.IsOverdue(transaction, today) {
+			continue
+		}
+
+		isNew, err := s.notificationRepo.CreateIfAbsent(ctx, entities.NewOverdueNotification(transaction))
+		if err != nil {
+			return err
+		}
+		if isNew {
+			created++
+		}
+	}
+
+	log.Printf("Alertas de despesas vencidas: %d nova(s) notificação(ões)", created)
+	return nil
+}
+
+// budgetNotifications verifica o uso dos orçamentos das categorias da transação
+// no mês dela; apenas o maior percentual atingido gera notificação
+func (s *notificationServiceImpl) budgetNotifications(ctx context.Context, userID uint, settings *entities.NotificationSettings, transaction *entities.Transaction) ([]*entities.Notification, error) {
+	if len(settings.BudgetThresholds) == 0 {
+		return nil, nil
+	}
+
+	categoryIDs := make(map[uint]bool)
+	if transaction.CategoryID != nil {
+		categoryIDs[*transaction.CategoryID] = true
+	}
+	for _, split := range transaction.Splits {
+		categoryIDs[split.CategoryID] = true
+	}
+	if len(categoryIDs) == 0 {
+		return nil, nil
+	}
+
+	month := entities.MonthStart(transaction.Date.UTC())
+	statuses, err := s.budgetService.GetBudgetStatus(ctx, userID, month.Year(), int(month.Month()))
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []*entities.Notification
+	for _, status := range statuses {
+		if !categoryIDs[status.Budget.CategoryID] || status.Available <= 0 {
+			continue
+		}
+		if threshold := settings.CrossedThreshold(status.Actual / status.Available * 100); threshold > 0 {
+			notifications = append(notifications, entities.NewBudgetNotification(status, threshold))
+		}
+	}
+
+	return notifications, nil
+}
+
+// startOfToday retorna o início do dia atual em UTC, como as datas das transações
+func startOfToday() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
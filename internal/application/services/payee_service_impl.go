@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"strings"
+)
+
+type payeeServiceImpl struct {
+	payeeRepo    repositories.PayeeRepository
+	categoryRepo repositories.CategoryRepository
+}
+
+func NewPayeeService(payeeRepo repositories.PayeeRepository, categoryRepo repositories.CategoryRepository) interfaces.PayeeService {
+	return &payeeServiceImpl{
+		payeeRepo:    payeeRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (s *payeeServiceImpl) CreatePayee(ctx context.Context, userID uint, payee *entities.Payee) (*entities.Payee, error) {
+	newPayee := entities.NewPayee(payee.Name, payee.Aliases, payee.DefaultCategoryID, userID)
+
+	if err := s.validatePayee(ctx, userID, newPayee); err != nil {
+		return nil, err
+	}
+
+	// Verificar se já existe um favorecido com o mesmo nome para o usuário
+	exists, err := s.payeeRepo.ExistsByName(ctx, userID, newPayee.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, pkgErrors.NewDomainError("already_exists", "Já existe um favorecido com este nome")
+	}
+
+	if err := s.payeeRepo.Create(ctx, newPayee); err != nil {
+		return nil, err
+	}
+
+	return newPayee, nil
+}
+
+func (s *payeeServiceImpl) GetPayeeByID(ctx context.Context, userID, payeeID uint) (*entities.Payee, error) {
+	payee, err := s.payeeRepo.GetByID(ctx, payeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se o favorecido pertence ao usuário
+	if !payee.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return payee, nil
+}
+
+func (s *payeeServiceImpl) GetPayeesByUser(ctx context.Context, userID uint) ([]*entities.Payee, error) {
+	return s.payeeRepo.GetByUserID(ctx, userID)
+}
+
+func (s *payeeServiceImpl) UpdatePayee(ctx context.Context, userID, payeeID uint, updates *entities.Payee) (*entities.Payee, error) {
+	payee, err := s.GetPayeeByID(ctx, userID, payeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se o novo nome já existe (se foi alterado)
+	if !strings.EqualFold(strings.TrimSpace(updates.Name), payee.Name) {
+		exists, err := s.payeeRepo.ExistsByName(ctx, userID, strings.TrimSpace(updates.Name))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, pkgErrors.NewDomainError("already_exists", "Já existe um favorecido com este nome")
+		}
+	}
+
+	payee.Update(updates.Name, updates.Aliases, updates.DefaultCategoryID)
+
+	if err := s.validatePayee(ctx, userID, payee); err != nil {
+		return nil, err
+	}
+
+	if err := s.payeeRepo.Update(ctx, payee); err != nil {
+		return nil, err
+	}
+
+	return payee, nil
+}
+
+func (s *payeeServiceImpl) DeletePayee(ctx context.Context, userID, payeeID uint) error {
+	if _, err := s.GetPayeeByID(ctx, userID, payeeID); err != nil {
+		return err
+	}
+
+	// As transações e regras do favorecido apenas perdem o vínculo
+	return s.payeeRepo.Delete(ctx, payeeID)
+}
+
+func (s *payeeServiceImpl) AssignPayees(ctx context.Context, userID uint, transactions []*entities.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	payees, err := s.payeeRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(payees) == 0 {
+		return nil
+	}
+
+	for _, transaction := range transactions {
+		entities.AssignPayee(payees, transaction)
+	}
+
+	return nil
+}
+
+func (s *payeeServiceImpl) validatePayee(ctx context.Context, userID uint, payee *entities.Payee) error {
+	if payee.Name == "" {
+		return pkgErrors.NewDomainError("validation_error", "Nome do favorecido é obrigatório")
+	}
+
+	for _, alias := range payee.Aliases {
+		if len(alias) > 100 {
+			return pkgErrors.NewDomainError("validation_error", "Apelidos devem ter no máximo 100 caracteres")
+		}
+	}
+
+	if payee.DefaultCategoryID != nil {
+		category, err := s.categoryRepo.GetByID(ctx, *payee.DefaultCategoryID)
+		if err != nil {
+			return err
+		}
+		if !category.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"strings"
+)
+
+// ruleBatchSize define quantas transações são carregadas por vez ao aplicar regras retroativamente
+const ruleBatchSize = 500
+
+type ruleServiceImpl struct {
+	ruleRepo        repositories.RuleRepository
+	transactionRepo repositories.TransactionRepository
+	accountRepo     repositories.AccountRepository
+	categoryRepo    repositories.CategoryRepository
+	tagRepo         repositories.TagRepository
+	payeeRepo       repositories.PayeeRepository
+}
+
+func NewRuleService(ruleRepo repositories.RuleRepository, transactionRepo repositories.TransactionRepository, accountRepo repositories.AccountRepository, categoryRepo repositories.CategoryRepository, tagRepo repositories.TagRepository, payeeRepo repositories.PayeeRepository) interfaces.RuleService {
+	return &ruleServiceImpl{
+		ruleRepo:        ruleRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		tagRepo:         tagRepo,
+		payeeRepo:       payeeRepo,
+	}
+}
+
+func (s *ruleServiceImpl) CreateRule(ctx context.Context, userID uint, rule *entities.Rule) (*entities.Rule, error) {
+	newRule := entities.NewRule(rule.Name, userID)
+	newRule.Update(rule)
+
+	if err := s.validateRule(ctx, userID, newRule); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Create(ctx, newRule); err != nil {
+		return nil, err
+	}
+
+	return newRule, nil
+}
+
+func (s *ruleServiceImpl) GetRuleByID(ctx context.Context, userID, ruleID uint) (*entities.Rule, error) {
+	rule, err := s.ruleRepo.GetByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se a regra pertence ao usuário
+	if !rule.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return rule, nil
+}
+
+func (s *ruleServiceImpl) GetRulesByUser(ctx context.Context, userID uint) ([]*entities.Rule, error) {
+	return s.ruleRepo.GetByUserID(ctx, userID)
+}
+
+func (s *ruleServiceImpl) UpdateRule(ctx context.Context, userID, ruleID uint, updates *entities.Rule) (*entities.Rule, error) {
+	rule, err := s.GetRuleByID(ctx, userID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Update(updates)
+
+	if err := s.validateRule(ctx, userID, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (s *ruleServiceImpl) DeleteRule(ctx context.Context, userID, ruleID uint) error {
+	if _, err := s.GetRuleByID(ctx, userID, ruleID); err != nil {
+		return err
+	}
+
+	return s.ruleRepo.Delete(ctx, ruleID)
+}
+
+func (s *ruleServiceImpl) PreviewRule(ctx context.Context, userID, ruleID uint, overwrite bool) ([]*entities.RuleChange, error) {
+	return s.runRule(ctx, userID, ruleID, overwrite, false)
+}
+
+func (s *ruleServiceImpl) ApplyRule(ctx context.Context, userID, ruleID uint, overwrite bool) ([]*entities.RuleChange, error) {
+	return s.runRule(ctx, userID, ruleID, overwrite, true)
+}
+
+func (s *ruleServiceImpl) ApplyActiveRules(ctx context.Context, userID uint, transactions []*entities.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	rules, err := s.ruleRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	for _, transaction := range transactions {
+		entities.ApplyRules(rules, transaction, false)
+	}
+
+	return nil
+}
+
+// runRule percorre as transações que podem ser afetadas pela regra e, quando
+// persist for verdadeiro, grava as alterações em uma única transação. A regra é avaliada mesmo se
+// estiver inativa, para permitir testá-la antes de ativá-la.
+func (s *ruleServiceImpl) runRule(ctx context.Context, userID, ruleID uint, overwrite, persist bool) ([]*entities.RuleChange, error) {
+	rule, err := s.GetRuleByID(ctx, userID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rule.Compile(); err != nil {
+		return nil, pkgErrors.NewDomainErrorWithDetails("validation_error", "Padrão de descrição inválido", err.Error())
+	}
+
+	candidate := *rule
+	candidate.Active = true
+
+	// Conta e tipo restringem a consulta; as demais condições são avaliadas em memória
+	filters := &repositories.TransactionFilters{
+		UserID:    userID,
+		AccountID: rule.AccountID,
+	}
+	if rule.TransactionType != nil {
+		transactionType := string(*rule.TransactionType)
+		filters.Type = &transactionType
+	}
+
+	changes := make([]*entities.RuleChange, 0)
+	var changed []*entities.Transaction
+	err = s.transactionRepo.StreamByUserID(ctx, userID, filters, ruleBatchSize, func(batch []*entities.Transaction) error {
+		for _, transaction := range batch {
+			change := entities.ApplyRules([]*entities.Rule{&candidate}, transaction, overwrite)
+			if change == nil {
+				continue
+			}
+
+			if persist {
+				changed = append(changed, transaction)
+			}
+			changes = append(changes, change)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changed) > 0 {
+		if err := s.transactionRepo.ApplyBulk(ctx, userID, changed, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *ruleServiceImpl) validateRule(ctx context.Context, userID uint, rule *entities.Rule) error {
+	if strings.TrimSpace(rule.Name) == "" {
+		return pkgErrors.NewDomainError("validation_error", "Nome da regra é obrigatório")
+	}
+
+	if !rule.HasConditions() {
+		return pkgErrors.NewDomainError("validation_error", "Regra deve ter ao menos uma condição")
+	}
+
+	if !rule.HasActions() {
+		return pkgErrors.NewDomainError("validation_error", "Regra deve definir uma categoria, um favorecido ou tags")
+	}
+
+	if err := rule.Compile(); err != nil {
+		return pkgErrors.NewDomainErrorWithDetails("validation_error", "Padrão de descrição inválido", err.Error())
+	}
+
+	if (rule.MinAmount != nil && *rule.MinAmount < 0) || (rule.MaxAmount != nil && *rule.MaxAmount < 0) {
+		return pkgErrors.NewDomainError("validation_error", "Valores da regra não podem ser negativos")
+	}
+
+	if rule.MinAmount != nil && rule.MaxAmount != nil && *rule.MinAmount > *rule.MaxAmount {
+		return pkgErrors.NewDomainError("validation_error", "Valor mínimo deve ser menor ou igual ao valor máximo")
+	}
+
+	if rule.TransactionType != nil {
+		switch *rule.TransactionType {
+		case entities.INCOME, entities.EXPENSE, entities.INVESTMENT:
+		default:
+			return pkgErrors.NewDomainError("validation_error", "Tipo de transação inválido para regras")
+		}
+	}
+
+	if rule.AccountID != nil {
+		account, err := s.accountRepo.GetByID(ctx, *rule.AccountID)
+		if err != nil {
+			return err
+		}
+		if !account.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+	}
+
+	if rule.SetCategoryID != nil {
+		category, err := s.categoryRepo.GetByID(ctx, *rule.SetCategoryID)
+		if err != nil {
+			return err
+		}
+		if !category.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+	}
+
+	if rule.SetPayeeID != nil {
+		payee, err := s.payeeRepo.GetByID(ctx, *rule.SetPayeeID)
+		if err != nil {
+			return err
+		}
+		if !payee.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+	}
+
+	return s.validateRuleTags(ctx, userID, rule.SetTagIDs)
+}
+
+func (s *ruleServiceImpl) validateRuleTags(ctx context.Context, userID uint, tagIDs []uint) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	tags, err := s.tagRepo.GetByIDs(ctx, tagIDs)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[uint]bool, len(tags))
+	for _, tag := range tags {
+		if !tag.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+		found[tag.ID] = true
+	}
+
+	for _, id := range tagIDs {
+		if !found[id] {
+			return pkgErrors.ErrTagNotFound
+		}
+	}
+
+	return nil
+}
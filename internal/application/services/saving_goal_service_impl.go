@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"log"
 	"my-finance-hub-api/internal/application/interfaces"
 	"my-finance-hub-api/internal/domain/entities"
 	"my-finance-hub-api/internal/domain/repositories"
@@ -10,6 +9,11 @@ import (
 	"time"
 )
 
+const (
+	savingGoalOpeningNote    = "Saldo inicial"
+	savingGoalAdjustmentNote = "Ajuste do valor atual"
+)
+
 type savingGoalServiceImpl struct {
 	savingGoalRepo repositories.SavingGoalRepository
 }
@@ -30,10 +34,19 @@ func (s *savingGoalServiceImpl) CreateSavingGoal(ctx context.Context, userID uin
 		return nil, pkgErrors.NewDomainError("validation_error", "Valor da meta deve ser maior que zero")
 	}
 
-	// Criar nova meta de economia
+	if savingGoal.CurrentAmount < 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor atual da meta não pode ser negativo")
+	}
+
+	// Criar nova meta de economia; o valor atual informado entra no razão como saldo inicial
 	newSavingGoal := entities.NewSavingGoal(savingGoal.Name, savingGoal.TargetAmount, userID, savingGoal.CurrentAmount, savingGoal.Description)
 
-	if err := s.savingGoalRepo.Create(ctx, newSavingGoal); err != nil {
+	var opening *entities.SavingGoalMovement
+	if newSavingGoal.CurrentAmount > 0 {
+		opening = entities.NewSavingGoalMovement(0, userID, entities.SAVING_ADJUSTMENT, newSavingGoal.CurrentAmount, newSavingGoal.CreatedAt, savingGoalOpeningNote)
+	}
+
+	if err := s.savingGoalRepo.Create(ctx, newSavingGoal, opening); err != nil {
 		return nil, err
 	}
 
@@ -74,14 +87,16 @@ func (s *savingGoalServiceImpl) UpdateSavingGoal(ctx context.Context, userID, sa
 		return nil, pkgErrors.NewDomainError("validation_error", "Valor da meta deve ser maior que zero")
 	}
 
-	if updates.CurrentAmount <= 0 {
-		return nil, pkgErrors.NewDomainError("validation_error", "Valor atual da meta deve ser maior que zero")
+	if updates.CurrentAmount < 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor atual da meta não pode ser negativo")
 	}
 
-	// Atualizar meta de economia
+	// Atualizar meta de economia; um valor atual diferente do saldo é registrado no
+	// razão como ajuste, calculado pelo repositório com a meta bloqueada
 	savingGoal.Update(updates.Name, updates.TargetAmount, updates.CurrentAmount, updates.Description)
+	adjustment := entities.NewSavingGoalMovement(savingGoalID, userID, entities.SAVING_ADJUSTMENT, 0, time.Now(), savingGoalAdjustmentNote)
 
-	if err := s.savingGoalRepo.Update(ctx, savingGoal); err != nil {
+	if err := s.savingGoalRepo.Update(ctx, savingGoal, adjustment); err != nil {
 		return nil, err
 	}
 
@@ -99,36 +114,72 @@ func (s *savingGoalServiceImpl) DeleteSavingGoal(ctx context.Context, userID, sa
 	return s.savingGoalRepo.Delete(ctx, savingGoalID)
 }
 
-func (s *savingGoalServiceImpl) Deposit(ctx context.Context, userID, savingGoalID uint, amount float64) (*entities.SavingGoal, error) {
+func (s *savingGoalServiceImpl) Deposit(ctx context.Context, userID, savingGoalID uint, amount float64, date time.Time, note string) (*entities.SavingGoal, error) {
 	// Validações
 	if amount <= 0 {
 		return nil, pkgErrors.NewDomainError("validation_error", "Valor do depósito deve ser maior que zero")
 	}
 
-	// Buscar meta de economia
-	savingGoal, err := s.GetSavingGoalByID(ctx, userID, savingGoalID)
+	return s.addMovement(ctx, userID, savingGoalID, entities.SAVING_DEPOSIT, amount, date, note)
+}
+
+func (s *savingGoalServiceImpl) Withdraw(ctx context.Context, userID, savingGoalID uint, amount float64, date time.Time, note string) (*entities.SavingGoal, error) {
+	// Validações
+	if amount <= 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor da retirada deve ser maior que zero")
+	}
+
+	return s.addMovement(ctx, userID, savingGoalID, entities.SAVING_WITHDRAWAL, amount, date, note)
+}
+
+func (s *savingGoalServiceImpl) GetMovements(ctx context.Context, userID, savingGoalID uint) ([]*entities.SavingGoalMovement, error) {
+	// Verificar se a meta de economia existe e pertence ao usuário
+	if _, err := s.GetSavingGoalByID(ctx, userID, savingGoalID); err != nil {
+		return nil, err
+	}
+
+	movements, err := s.savingGoalRepo.GetMovements(ctx, savingGoalID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Log de depuração
-	log.Printf("Depósito - ID: %d, Valor atual: %.2f, Depósito: %.2f",
-		savingGoalID, savingGoal.CurrentAmount, amount)
+	entities.ApplySavingGoalBalances(movements)
+	return movements, nil
+}
+
+func (s *savingGoalServiceImpl) GetHistory(ctx context.Context, userID, savingGoalID uint) ([]entities.SavingGoalHistoryPoint, error) {
+	// Verificar se a meta de economia existe e pertence ao usuário
+	if _, err := s.GetSavingGoalByID(ctx, userID, savingGoalID); err != nil {
+		return nil, err
+	}
+
+	movements, err := s.savingGoalRepo.GetMovements(ctx, savingGoalID)
+	if err != nil {
+		return nil, err
+	}
 
-	// Calcular novo valor
-	newAmount := savingGoal.CurrentAmount + amount
+	return entities.SavingGoalMonthlyHistory(movements, time.Now()), nil
+}
 
-	// Atualizar valor no banco de dados
-	if err := s.savingGoalRepo.(repositories.SavingGoalRepository).UpdateAmount(ctx, savingGoalID, newAmount); err != nil {
-		log.Printf("Erro ao atualizar valor: %v", err)
+// addMovement grava a movimentação no razão; o repositório recalcula o valor atual
+// e rejeita retiradas maiores que o saldo
+func (s *savingGoalServiceImpl) addMovement(ctx context.Context, userID, savingGoalID uint, kind entities.SavingGoalMovementKind, amount float64, date time.Time, note string) (*entities.SavingGoal, error) {
+	savingGoal, err := s.GetSavingGoalByID(ctx, userID, savingGoalID)
+	if err != nil {
 		return nil, err
 	}
 
-	// Log após atualização
-	log.Printf("Depósito concluído - ID: %d, Novo valor: %.2f", savingGoalID, newAmount)
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	movement := entities.NewSavingGoalMovement(savingGoalID, userID, kind, amount, date, note)
+	balance, err := s.savingGoalRepo.AddMovement(ctx, movement)
+	if err != nil {
+		return nil, err
+	}
 
-	// Atualizar valor na entidade
-	savingGoal.CurrentAmount = newAmount
+	savingGoal.CurrentAmount = balance
 	savingGoal.UpdatedAt = time.Now()
 
 	return savingGoal, nil
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"strings"
+)
+
+type tagServiceImpl struct {
+	tagRepo repositories.TagRepository
+}
+
+func NewTagService(tagRepo repositories.TagRepository) interfaces.TagService {
+	return &tagServiceImpl{
+		tagRepo: tagRepo,
+	}
+}
+
+func (s *tagServiceImpl) CreateTag(ctx context.Context, userID uint, tag *entities.Tag) (*entities.Tag, error) {
+	// Validações
+	if strings.TrimSpace(tag.Name) == "" {
+		return nil, pkgErrors.NewDomainError("validation_error", "Nome da tag é obrigatório")
+	}
+
+	// Verificar se já existe uma tag com o mesmo nome para o usuário
+	exists, err := s.tagRepo.ExistsByName(ctx, userID, tag.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, pkgErrors.NewDomainError("already_exists", "Já existe uma tag com este nome")
+	}
+
+	newTag := entities.NewTag(tag.Name, tag.Color, userID)
+
+	if err := s.tagRepo.Create(ctx, newTag); err != nil {
+		return nil, err
+	}
+
+	return newTag, nil
+}
+
+func (s *tagServiceImpl) GetTagByID(ctx context.Context, userID, tagID uint) (*entities.Tag, error) {
+	tag, err := s.tagRepo.GetByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se a tag pertence ao usuário
+	if !tag.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return tag, nil
+}
+
+func (s *tagServiceImpl) GetTagsByUser(ctx context.Context, userID uint) ([]*entities.Tag, error) {
+	return s.tagRepo.GetByUserID(ctx, userID)
+}
+
+func (s *tagServiceImpl) UpdateTag(ctx context.Context, userID, tagID uint, updates *entities.Tag) (*entities.Tag, error) {
+	// Buscar tag existente
+	tag, err := s.GetTagByID(ctx, userID, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validações
+	if strings.TrimSpace(updates.Name) == "" {
+		return nil, pkgErrors.NewDomainError("validation_error", "Nome da tag é obrigatório")
+	}
+
+	// Verificar se o novo nome já existe (se foi alterado)
+	if !strings.EqualFold(updates.Name, tag.Name) {
+		exists, err := s.tagRepo.ExistsByName(ctx, userID, updates.Name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, pkgErrors.NewDomainError("already_exists", "Já existe uma tag com este nome")
+		}
+	}
+
+	tag.Update(updates.Name, updates.Color)
+
+	if err := s.tagRepo.Update(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func (s *tagServiceImpl) DeleteTag(ctx context.Context, userID, tagID uint) error {
+	// Verificar se a tag existe e pertence ao usuário
+	if _, err := s.GetTagByID(ctx, userID, tagID); err != nil {
+		return err
+	}
+
+	// Os vínculos com transações são removidos em cascata
+	return s.tagRepo.Delete(ctx, tagID)
+}
@@ -2,21 +2,58 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"my-finance-hub-api/internal/application/interfaces"
 	"my-finance-hub-api/internal/domain/entities"
 	"my-finance-hub-api/internal/domain/repositories"
 	pkgErrors "my-finance-hub-api/pkg/errors"
+	"sort"
 	"time"
 )
 
+// recurrenceHorizonMonths define até quantos meses à frente as ocorrências de
+// séries sem data de término são geradas
+const recurrenceHorizonMonths = 3
+
+// recurrenceMaxOccurrencesPerRun limita quantas ocorrências de uma série são criadas
+// de uma vez; as restantes são geradas nas execuções seguintes
+const recurrenceMaxOccurrencesPerRun = 60
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 200
+	defaultPageLimit   = 50
+	maxPageLimit       = 200
+	// maxBulkItems limita quantas transações uma operação em lote pode alterar
+	maxBulkItems = 500
+)
+
+var errTransferEndpoint = pkgErrors.NewDomainError("validation_error", "Transferências devem ser criadas pelo endpoint de transferências")
+
 type transactionServiceImpl struct {
-	transactionRepo repositories.TransactionRepository
+	transactionRepo     repositories.TransactionRepository
+	accountRepo         repositories.AccountRepository
+	invoiceRepo         repositories.InvoiceRepository
+	tagRepo             repositories.TagRepository
+	categoryRepo        repositories.CategoryRepository
+	ruleService         interfaces.RuleService
+	payeeService        interfaces.PayeeService
+	budgetService       interfaces.BudgetService
+	notificationService interfaces.NotificationService
 }
 
-func NewTransactionService(transactionRepo repositories.TransactionRepository) interfaces.TransactionService {
+func NewTransactionService(transactionRepo repositories.TransactionRepository, accountRepo repositories.AccountRepository, invoiceRepo repositories.InvoiceRepository, tagRepo repositories.TagRepository, categoryRepo repositories.CategoryRepository, ruleService interfaces.RuleService, payeeService interfaces.PayeeService, budgetService interfaces.BudgetService, notificationService interfaces.NotificationService) interfaces.TransactionService {
 	return &transactionServiceImpl{
-		transactionRepo: transactionRepo,
+		transactionRepo:     transactionRepo,
+		accountRepo:         accountRepo,
+		invoiceRepo:         invoiceRepo,
+		tagRepo:             tagRepo,
+		categoryRepo:        categoryRepo,
+		ruleService:         ruleService,
+		payeeService:        payeeService,
+		budgetService:       budgetService,
+		notificationService: notificationService,
 	}
 }
 
@@ -34,6 +71,35 @@ func (s *transactionServiceImpl) CreateTransaction(ctx context.Context, userID u
 		return nil, pkgErrors.NewDomainError("validation_error", "Tipo da transação é obrigatório")
 	}
 
+	if transaction.IsTransfer() {
+		return nil, errTransferEndpoint
+	}
+
+	if err := s.validateAccount(ctx, userID, transaction.AccountID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateSplits(transaction); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTags(ctx, userID, transaction.TagIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.validatePayee(ctx, userID, transaction.PayeeID); err != nil {
+		return nil, err
+	}
+
+	if transaction.IsRecurrent {
+		if transaction.RecurrenceType == "" || transaction.RecurrenceType == entities.NONE || !transaction.RecurrenceType.IsValid() {
+			return nil, pkgErrors.NewDomainError("validation_error", "Tipo de recorrência inválido")
+		}
+		if transaction.RecurrenceEnd != nil && transaction.RecurrenceEnd.Before(transaction.Date) {
+			return nil, pkgErrors.NewDomainError("validation_error", "Fim da recorrência deve ser posterior à data da transação")
+		}
+	}
+
 	// Criar nova transação
 	newTransaction := entities.NewTransaction(
 		transaction.Description,
@@ -47,41 +113,554 @@ func (s *transactionServiceImpl) CreateTransaction(ctx context.Context, userID u
 	if transaction.CategoryID != nil {
 		newTransaction.SetCategory(*transaction.CategoryID)
 	}
+	if transaction.PayeeID != nil {
+		newTransaction.SetPayee(*transaction.PayeeID)
+	}
 	if transaction.PiggyBankID != nil {
 		newTransaction.SetPiggyBank(*transaction.PiggyBankID)
 	}
+	if transaction.AccountID != nil {
+		newTransaction.SetAccount(*transaction.AccountID)
+	}
 	if transaction.IsRecurrent {
 		newTransaction.SetRecurrence(transaction.RecurrenceType, transaction.RecurrenceEnd)
 	}
+	if transaction.HasSplits() {
+		newTransaction.SetSplits(transaction.Splits)
+	}
+	if len(transaction.TagIDs) > 0 {
+		newTransaction.SetTags(transaction.TagIDs)
+	}
+	newTransaction.Notes = transaction.Notes
+	newTransaction.ParentID = transaction.ParentID
+	newTransaction.Paid = transaction.Paid
+	newTransaction.ExternalID = transaction.ExternalID
+
+	// Regras do usuário completam categoria, favorecido e tags sem substituir o que
+	// foi informado; em seguida o favorecido é reconhecido pela descrição
+	if err := s.ruleService.ApplyActiveRules(ctx, userID, []*entities.Transaction{newTransaction}); err != nil {
+		return nil, err
+	}
+	if err := s.payeeService.AssignPayees(ctx, userID, []*entities.Transaction{newTransaction}); err != nil {
+		return nil, err
+	}
+
+	if err := s.assignInvoice(ctx, newTransaction); err != nil {
+		return nil, err
+	}
+
+	// O modelo de uma série recorrente é gravado junto com as ocorrências
+	if newTransaction.IsRecurrenceTemplate() {
+		if err := s.generateOccurrences(ctx, newTransaction, nil); err != nil {
+			return nil, err
+		}
+	} else if err := s.transactionRepo.Create(ctx, newTransaction); err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, userID, newTransaction)
+
+	return newTransaction, nil
+}
+
+func (s *transactionServiceImpl) CreateInstallmentPurchase(ctx context.Context, userID uint, transaction *entities.Transaction, installments int, interestRate float64, remainderOnLast bool) ([]*entities.Transaction, error) {
+	// Validações
+	if transaction.Description == "" {
+		return nil, pkgErrors.NewDomainError("validation_error", "Descrição da transação é obrigatória")
+	}
+
+	if transaction.Amount <= 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor da transação deve ser maior que zero")
+	}
+
+	if transaction.Type == "" {
+		return nil, pkgErrors.NewDomainError("validation_error", "Tipo da transação é obrigatório")
+	}
+
+	if installments < 2 || installments > entities.MaxInstallments {
+		return nil, pkgErrors.NewDomainErrorWithDetails("validation_error", "Quantidade de parcelas inválida",
+			fmt.Sprintf("informe entre 2 e %d parcelas", entities.MaxInstallments))
+	}
+
+	if interestRate < 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Taxa de juros não pode ser negativa")
+	}
+
+	if transaction.IsRecurrent {
+		return nil, pkgErrors.NewDomainError("validation_error", "Compra parcelada não pode ser recorrente")
+	}
+
+	if transaction.HasSplits() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Compra parcelada não pode ser dividida entre categorias")
+	}
+
+	if transaction.IsTransfer() {
+		return nil, errTransferEndpoint
+	}
+
+	if err := s.validateAccount(ctx, userID, transaction.AccountID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTags(ctx, userID, transaction.TagIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.validatePayee(ctx, userID, transaction.PayeeID); err != nil {
+		return nil, err
+	}
+
+	purchase := entities.NewTransaction(transaction.Description, transaction.Amount, transaction.Type, transaction.Date, userID)
+	purchase.Notes = transaction.Notes
+	purchase.CategoryID = transaction.CategoryID
+	purchase.PayeeID = transaction.PayeeID
+	purchase.PiggyBankID = transaction.PiggyBankID
+	purchase.AccountID = transaction.AccountID
+	purchase.TagIDs = transaction.TagIDs
+
+	// Regras e favorecidos são aplicados à compra, e as parcelas herdam o resultado
+	if err := s.ruleService.ApplyActiveRules(ctx, userID, []*entities.Transaction{purchase}); err != nil {
+		return nil, err
+	}
+	if err := s.payeeService.AssignPayees(ctx, userID, []*entities.Transaction{purchase}); err != nil {
+		return nil, err
+	}
+
+	amounts := entities.SplitInstallments(transaction.Amount, installments, interestRate, remainderOnLast)
+	created := make([]*entities.Transaction, 0, installments)
+
+	for i, amount := range amounts {
+		installment := purchase.NewInstallment(i+1, installments, amount)
+		if i == 0 {
+			installment.Paid = transaction.Paid
+		}
+
+		if err := s.assignInvoice(ctx, installment); err != nil {
+			return nil, err
+		}
+		created = append(created, installment)
+	}
+
+	// Todas as parcelas são gravadas juntas, para que a compra nunca fique incompleta
+	if err := s.transactionRepo.CreateInstallments(ctx, created); err != nil {
+		return nil, err
+	}
+
+	for _, installment := range created {
+		s.notify(ctx, userID, installment)
+	}
+
+	return created, nil
+}
+
+func (s *transactionServiceImpl) GetInstallments(ctx context.Context, userID, transactionID uint) ([]*entities.Transaction, error) {
+	transaction, err := s.GetTransactionByID(ctx, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !transaction.IsInstallment() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Transação não faz parte de uma compra parcelada")
+	}
+
+	return s.transactionRepo.GetInstallments(ctx, transaction.InstallmentGroupID())
+}
+
+func (s *transactionServiceImpl) UpdateRemainingInstallments(ctx context.Context, userID, transactionID uint, updates *entities.Transaction) ([]*entities.Transaction, error) {
+	remaining, err := s.getRemainingInstallments(ctx, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Amount < 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor da parcela deve ser maior que zero")
+	}
+
+	for _, installment := range remaining {
+		if updates.Description != "" {
+			installment.SetInstallmentDescription(updates.Description)
+		}
+		if updates.Amount > 0 {
+			installment.Amount = updates.Amount
+		}
+		if updates.CategoryID != nil {
+			installment.SetCategory(*updates.CategoryID)
+		}
+		installment.UpdatedAt = time.Now()
+	}
+
+	if err := s.transactionRepo.ApplyBulk(ctx, userID, remaining, nil); err != nil {
+		return nil, err
+	}
+
+	return remaining, nil
+}
+
+func (s *transactionServiceImpl) CancelRemainingInstallments(ctx context.Context, userID, transactionID uint) error {
+	remaining, err := s.getRemainingInstallments(ctx, userID, transactionID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uint, len(remaining))
+	for i, installment := range remaining {
+		ids[i] = installment.ID
+	}
+
+	return s.transactionRepo.ApplyBulk(ctx, userID, nil, ids)
+}
+
+// getRemainingInstallments retorna as parcelas não pagas a partir da parcela informada
+func (s *transactionServiceImpl) getRemainingInstallments(ctx context.Context, userID, transactionID uint) ([]*entities.Transaction, error) {
+	transaction, err := s.GetTransactionByID(ctx, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !transaction.IsInstallment() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Transação não faz parte de uma compra parcelada")
+	}
+
+	installments, err := s.transactionRepo.GetInstallments(ctx, transaction.InstallmentGroupID())
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining []*entities.Transaction
+	for _, installment := range installments {
+		if installment.InstallmentNumber >= transaction.InstallmentNumber && !installment.Paid {
+			remaining = append(remaining, installment)
+		}
+	}
+
+	if len(remaining) == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Não há parcelas em aberto a partir desta parcela")
+	}
+
+	return remaining, nil
+}
+
+func (s *transactionServiceImpl) CreateTransfer(ctx context.Context, userID, fromAccountID, toAccountID uint, transfer *entities.Transaction) ([]*entities.Transaction, error) {
+	// Validações
+	if transfer.Amount <= 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor da transferência deve ser maior que zero")
+	}
+
+	if fromAccountID == toAccountID {
+		return nil, pkgErrors.NewDomainError("validation_error", "Conta de origem e destino devem ser diferentes")
+	}
+
+	for _, accountID := range []uint{fromAccountID, toAccountID} {
+		if err := s.validateAccount(ctx, userID, &accountID); err != nil {
+			return nil, err
+		}
+	}
+
+	description := transfer.Description
+	if description == "" {
+		description = "Transferência entre contas"
+	}
+
+	debit, credit := entities.NewTransferPair(description, transfer.Amount, transfer.Date, userID, fromAccountID, toAccountID)
+	debit.Paid = transfer.Paid
+	credit.Paid = transfer.Paid
+
+	if err := s.transactionRepo.CreateTransfer(ctx, debit, credit); err != nil {
+		return nil, err
+	}
+
+	return []*entities.Transaction{debit, credit}, nil
+}
+
+// updateTransfer aplica a alteração nas duas pernas da transferência
+func (s *transactionServiceImpl) updateTransfer(ctx context.Context, userID uint, transaction, updates *entities.Transaction) (*entities.Transaction, error) {
+	debit, credit, err := s.getTransferLegs(ctx, userID, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// A conta pode ser alterada apenas na perna editada
+	if updates.AccountID != nil && (transaction.AccountID == nil || *updates.AccountID != *transaction.AccountID) {
+		if err := s.validateAccount(ctx, userID, updates.AccountID); err != nil {
+			return nil, err
+		}
+
+		counterpart := credit
+		if transaction.ID == credit.ID {
+			counterpart = debit
+		}
+		if counterpart.AccountID != nil && *counterpart.AccountID == *updates.AccountID {
+			return nil, pkgErrors.NewDomainError("validation_error", "Conta de origem e destino devem ser diferentes")
+		}
+	}
+
+	for _, leg := range []*entities.Transaction{debit, credit} {
+		leg.Update(updates.Description, updates.Amount, entities.TRANSFER, updates.Date)
+		leg.Paid = updates.Paid
+		leg.Notes = updates.Notes
+		if leg.ID == transaction.ID && updates.AccountID != nil {
+			leg.SetAccount(*updates.AccountID)
+		}
+	}
+
+	if err := s.transactionRepo.UpdateTransfer(ctx, debit, credit); err != nil {
+		return nil, err
+	}
+
+	if transaction.ID == debit.ID {
+		return debit, nil
+	}
+	return credit, nil
+}
+
+// getTransferLegs retorna as pernas de saída e de entrada de uma transferência
+func (s *transactionServiceImpl) getTransferLegs(ctx context.Context, userID uint, transaction *entities.Transaction) (*entities.Transaction, *entities.Transaction, error) {
+	if transaction.TransferPairID == nil {
+		return nil, nil, pkgErrors.NewDomainError("validation_error", "Transferência sem perna correspondente")
+	}
+
+	counterpart, err := s.GetTransactionByID(ctx, userID, *transaction.TransferPairID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if transaction.TransferDirection == entities.TRANSFER_OUT {
+		return transaction, counterpart, nil
+	}
+	return counterpart, transaction, nil
+}
+
+// assignInvoice vincula despesas e estornos lançados em cartão de crédito à
+// fatura correspondente à data da transação
+func (s *transactionServiceImpl) assignInvoice(ctx context.Context, transaction *entities.Transaction) error {
+	transaction.InvoiceID = nil
+
+	if transaction.AccountID == nil || (transaction.Type != entities.EXPENSE && transaction.Type != entities.INCOME) {
+		return nil
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, *transaction.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if !account.IsCreditCard() || account.ClosingDay == 0 || account.DueDay == 0 {
+		return nil
+	}
+
+	// Fatura já paga não recebe lançamentos: a compra entra na fatura seguinte
+	closingDate, dueDate := account.InvoiceDatesFor(transaction.Date)
+	invoice := entities.NewInvoice(account.ID, account.UserID, closingDate, dueDate)
+	for {
+		if err := s.invoiceRepo.GetOrCreate(ctx, invoice); err != nil {
+			return err
+		}
+		if !invoice.IsPaid() {
+			break
+		}
+		closingDate, dueDate = account.InvoiceDatesFor(invoice.ClosingDate)
+		invoice = entities.NewInvoice(account.ID, account.UserID, closingDate, dueDate)
+	}
+
+	transaction.InvoiceID = &invoice.ID
+	return nil
+}
+
+// validateSplits garante que as linhas de divisão são válidas e fecham com o valor da transação
+func (s *transactionServiceImpl) validateSplits(transaction *entities.Transaction) error {
+	if !transaction.HasSplits() {
+		return nil
+	}
+
+	if transaction.IsTransfer() {
+		return pkgErrors.NewDomainError("validation_error", "Transferências não podem ser divididas entre categorias")
+	}
+
+	if transaction.IsInstallment() {
+		return pkgErrors.NewDomainError("validation_error", "Parcelas não podem ser divididas entre categorias")
+	}
+
+	for _, split := range transaction.Splits {
+		if split.CategoryID == 0 {
+			return pkgErrors.NewDomainError("validation_error", "Categoria da divisão é obrigatória")
+		}
+		if split.Amount <= 0 {
+			return pkgErrors.NewDomainError("validation_error", "Valor da divisão deve ser maior que zero")
+		}
+	}
+
+	if !transaction.SplitsMatchAmount() {
+		return pkgErrors.NewDomainErrorWithDetails("validation_error", "Soma das divisões deve ser igual ao valor da transação",
+			fmt.Sprintf("soma das divisões: %.2f, valor da transação: %.2f", transaction.SplitsTotal(), transaction.Amount))
+	}
+
+	return nil
+}
+
+// validateTags garante que todas as tags informadas existam e pertençam ao usuário
+func (s *transactionServiceImpl) validateTags(ctx context.Context, userID uint, tagIDs []uint) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	tags, err := s.tagRepo.GetByIDs(ctx, tagIDs)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[uint]bool, len(tags))
+	for _, tag := range tags {
+		if !tag.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+		found[tag.ID] = true
+	}
+
+	for _, id := range tagIDs {
+		if !found[id] {
+			return pkgErrors.ErrTagNotFound
+		}
+	}
+
+	return nil
+}
+
+// validatePayee garante que o favorecido informado exista e pertença ao usuário
+func (s *transactionServiceImpl) validatePayee(ctx context.Context, userID uint, payeeID *uint) error {
+	if payeeID == nil {
+		return nil
+	}
+
+	_, err := s.payeeService.GetPayeeByID(ctx, userID, *payeeID)
+	return err
+}
+
+// validateAccount garante que a conta informada exista, pertença ao usuário e não esteja arquivada
+func (s *transactionServiceImpl) validateAccount(ctx context.Context, userID uint, accountID *uint) error {
+	if accountID == nil {
+		return nil
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, *accountID)
+	if err != nil {
+		return err
+	}
+
+	if !account.BelongsToUser(userID) {
+		return pkgErrors.ErrForbidden
+	}
+
+	if account.Archived {
+		return pkgErrors.NewDomainError("validation_error", "Conta arquivada não aceita novas transações")
+	}
+
+	return nil
+}
+
+func (s *transactionServiceImpl) GetTransactionByID(ctx context.Context, userID, transactionID uint) (*entities.Transaction, error) {
+	transaction, err := s.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se a transação pertence ao usuário
+	if !transaction.BelongsToUser(userID) {
+		return nil, pkgErrors.ErrForbidden
+	}
+
+	return transaction, nil
+}
+
+func (s *transactionServiceImpl) GetTransactionsByUser(ctx context.Context, userID uint, filters *repositories.TransactionFilters) ([]*entities.Transaction, error) {
+	if filters == nil {
+		filters = &repositories.TransactionFilters{}
+	}
+	filters.UserID = userID
+
+	return s.transactionRepo.GetByUserID(ctx, userID, filters)
+}
+
+func (s *transactionServiceImpl) ListTransactions(ctx context.Context, userID uint, filters *repositories.TransactionFilters, page *repositories.TransactionPageRequest) (*repositories.TransactionPage, error) {
+	if filters == nil {
+		filters = &repositories.TransactionFilters{}
+	}
+	filters.UserID = userID
+
+	if page == nil {
+		page = &repositories.TransactionPageRequest{Descending: true}
+	}
+	if page.SortBy == "" {
+		page.SortBy = repositories.SORT_BY_DATE
+	}
+	if !page.SortBy.IsValid() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Campo de ordenação inválido")
+	}
+	if page.Limit <= 0 {
+		page.Limit = defaultPageLimit
+	}
+	if page.Limit > maxPageLimit {
+		page.Limit = maxPageLimit
+	}
+
+	// O cursor só vale para a mesma ordenação que o gerou
+	if page.After != nil && (page.After.SortBy != page.SortBy || page.After.Descending != page.Descending) {
+		return nil, pkgErrors.NewDomainError("validation_error", "Cursor não corresponde à ordenação informada")
+	}
+
+	if filters.MinAmount != nil && filters.MaxAmount != nil && *filters.MinAmount > *filters.MaxAmount {
+		return nil, pkgErrors.NewDomainError("validation_error", "Valor mínimo deve ser menor ou igual ao valor máximo")
+	}
+	if !filters.StartDate.IsZero() && !filters.EndDate.IsZero() && filters.EndDate.Before(filters.StartDate) {
+		return nil, pkgErrors.NewDomainError("validation_error", "Data final deve ser posterior à data inicial")
+	}
+
+	transactions, hasMore, err := s.transactionRepo.ListByUserID(ctx, userID, filters, page)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.transactionRepo.GetTotals(ctx, userID, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &repositories.TransactionPage{
+		Transactions: transactions,
+		HasMore:      hasMore,
+		Totals:       totals,
+	}
 
-	if err := s.transactionRepo.Create(ctx, newTransaction); err != nil {
-		return nil, err
+	if hasMore {
+		last := transactions[len(transactions)-1]
+		result.NextCursor = &repositories.TransactionCursor{
+			SortBy:     page.SortBy,
+			Descending: page.Descending,
+			Value:      page.SortBy.Value(last),
+			ID:         last.ID,
+		}
 	}
 
-	return newTransaction, nil
+	return result, nil
 }
 
-func (s *transactionServiceImpl) GetTransactionByID(ctx context.Context, userID, transactionID uint) (*entities.Transaction, error) {
-	transaction, err := s.transactionRepo.GetByID(ctx, transactionID)
-	if err != nil {
-		return nil, err
+func (s *transactionServiceImpl) SearchTransactions(ctx context.Context, userID uint, text string, filters *repositories.TransactionFilters, limit int) ([]*entities.TransactionSearchHit, error) {
+	if entities.NormalizeDescription(text) == "" {
+		return nil, pkgErrors.NewDomainError("validation_error", "Informe ao menos uma palavra para a busca")
 	}
 
-	// Verificar se a transação pertence ao usuário
-	if !transaction.BelongsToUser(userID) {
-		return nil, pkgErrors.ErrForbidden
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
 	}
 
-	return transaction, nil
-}
-
-func (s *transactionServiceImpl) GetTransactionsByUser(ctx context.Context, userID uint, filters *repositories.TransactionFilters) ([]*entities.Transaction, error) {
 	if filters == nil {
 		filters = &repositories.TransactionFilters{}
 	}
 	filters.UserID = userID
 
-	return s.transactionRepo.GetByUserID(ctx, userID, filters)
+	return s.transactionRepo.Search(ctx, userID, text, filters, limit)
 }
 
 func (s *transactionServiceImpl) UpdateTransaction(ctx context.Context, userID, transactionID uint, updates *entities.Transaction) (*entities.Transaction, error) {
@@ -104,35 +683,436 @@ func (s *transactionServiceImpl) UpdateTransaction(ctx context.Context, userID,
 		return nil, pkgErrors.NewDomainError("validation_error", "Tipo da transação é obrigatório")
 	}
 
+	// Transferências atualizam as duas pernas juntas e não mudam de tipo
+	if transaction.IsTransfer() || updates.IsTransfer() {
+		if transaction.IsTransfer() != updates.IsTransfer() {
+			return nil, pkgErrors.NewDomainError("validation_error", "Não é possível converter entre transferência e outros tipos")
+		}
+		if err := s.validateSplits(updates); err != nil {
+			return nil, err
+		}
+		return s.updateTransfer(ctx, userID, transaction, updates)
+	}
+
+	wasTemplate := transaction.IsRecurrenceTemplate()
+	previous := transaction.Clone()
+
 	// Atualizar transação
 	transaction.Update(updates.Description, updates.Amount, updates.Type, updates.Date)
+	transaction.Notes = updates.Notes
 
 	// Atualizar campos opcionais
 	if updates.CategoryID != nil {
 		transaction.SetCategory(*updates.CategoryID)
 	}
+	if updates.PayeeID != nil {
+		if err := s.validatePayee(ctx, userID, updates.PayeeID); err != nil {
+			return nil, err
+		}
+		transaction.SetPayee(*updates.PayeeID)
+	}
 	if updates.PiggyBankID != nil {
 		transaction.SetPiggyBank(*updates.PiggyBankID)
 	}
+	if updates.AccountID != nil {
+		if err := s.validateAccount(ctx, userID, updates.AccountID); err != nil {
+			return nil, err
+		}
+		transaction.SetAccount(*updates.AccountID)
+	}
+	if updates.IsRecurrent {
+		if !updates.RecurrenceType.IsValid() || updates.RecurrenceType == entities.NONE {
+			return nil, pkgErrors.NewDomainError("validation_error", "Tipo de recorrência inválido")
+		}
+		if updates.RecurrenceEnd != nil && updates.RecurrenceEnd.Before(updates.Date) {
+			return nil, pkgErrors.NewDomainError("validation_error", "Fim da recorrência deve ser posterior à data da transação")
+		}
+		transaction.SetRecurrence(updates.RecurrenceType, updates.RecurrenceEnd)
+	} else if transaction.IsRecurrent {
+		transaction.ClearRecurrence()
+	}
+	// Splits nil mantém as divisões atuais; lista vazia remove a divisão
+	if updates.Splits != nil {
+		transaction.SetSplits(updates.Splits)
+	}
+	// Da mesma forma, TagIDs nil mantém as tags atuais
+	if updates.TagIDs != nil {
+		if err := s.validateTags(ctx, userID, updates.TagIDs); err != nil {
+			return nil, err
+		}
+		transaction.SetTags(updates.TagIDs)
+	}
+	transaction.Paid = updates.Paid
 
-	if err := s.transactionRepo.Update(ctx, transaction); err != nil {
+	if err := s.validateSplits(transaction); err != nil {
+		return nil, err
+	}
+
+	// Data ou conta podem ter mudado a fatura da compra
+	if err := s.assignInvoice(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	if wasTemplate || transaction.IsRecurrenceTemplate() {
+		if err := s.reconcileOccurrences(ctx, previous, transaction); err != nil {
+			return nil, err
+		}
+	} else if err := s.transactionRepo.Update(ctx, transaction); err != nil {
 		return nil, err
 	}
 
+	s.notify(ctx, userID, transaction)
+
 	return transaction, nil
 }
 
+// notify avalia os alertas da transação depois de gravada; uma falha nos alertas
+// é apenas registrada e não desfaz a operação
+func (s *transactionServiceImpl) notify(ctx context.Context, userID uint, transaction *entities.Transaction) {
+	if err := s.notificationService.EvaluateTransaction(ctx, userID, transaction); err != nil {
+		log.Printf("Erro ao avaliar alertas da transação %d: %v", transaction.ID, err)
+	}
+}
+
 func (s *transactionServiceImpl) DeleteTransaction(ctx context.Context, userID, transactionID uint) error {
 	// Verificar se a transação existe e pertence ao usuário
-	_, err := s.GetTransactionByID(ctx, userID, transactionID)
+	transaction, err := s.GetTransactionByID(ctx, userID, transactionID)
 	if err != nil {
 		return err
 	}
 
+	// Transferências são excluídas junto com a outra perna
+	if transaction.IsTransfer() {
+		debit, credit, err := s.getTransferLegs(ctx, userID, transaction)
+		if err != nil {
+			return err
+		}
+		return s.transactionRepo.DeleteTransfer(ctx, debit.ID, credit.ID)
+	}
+
 	// Excluir transação
 	return s.transactionRepo.Delete(ctx, transactionID)
 }
 
+func (s *transactionServiceImpl) FindDuplicateCandidates(ctx context.Context, userID uint, transactions []*entities.Transaction) ([][]uint, error) {
+	candidates := make([][]uint, len(transactions))
+	if len(transactions) == 0 {
+		return candidates, nil
+	}
+
+	// Uma única consulta cobre o intervalo de datas de todas as transações
+	start, end := transactions[0].Date, transactions[0].Date
+	for _, transaction := range transactions[1:] {
+		if transaction.Date.Before(start) {
+			start = transaction.Date
+		}
+		if transaction.Date.After(end) {
+			end = transaction.Date
+		}
+	}
+
+	existing, err := s.transactionRepo.GetByDateRange(ctx, userID,
+		start.Add(-entities.DuplicateDateWindow), end.Add(entities.DuplicateDateWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, transaction := range transactions {
+		for _, other := range existing {
+			if transaction.IsLikelyDuplicateOf(other) {
+				candidates[i] = append(candidates[i], other.ID)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+func (s *transactionServiceImpl) GetDuplicateGroups(ctx context.Context, userID uint) ([]*entities.DuplicateGroup, error) {
+	pairs, err := s.transactionRepo.GetDuplicatePairs(ctx, userID, entities.DuplicateDateWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint
+	for _, pair := range pairs {
+		ids = append(ids, pair[0], pair[1])
+	}
+
+	transactions, err := s.transactionRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]*entities.Transaction, len(transactions))
+	for _, transaction := range transactions {
+		byID[transaction.ID] = transaction
+	}
+
+	// Union-find: pares que compartilham uma transação formam um único grupo
+	parent := make(map[uint]uint)
+	var find func(id uint) uint
+	find = func(id uint) uint {
+		if parent[id] == 0 || parent[id] == id {
+			parent[id] = id
+			return id
+		}
+		root := find(parent[id])
+		parent[id] = root
+		return root
+	}
+
+	for _, pair := range pairs {
+		first, second := byID[pair[0]], byID[pair[1]]
+		if first == nil || second == nil || !first.IsLikelyDuplicateOf(second) {
+			continue
+		}
+		parent[find(second.ID)] = find(first.ID)
+	}
+
+	groupsByRoot := make(map[uint]*entities.DuplicateGroup)
+	var groups []*entities.DuplicateGroup
+	for _, transaction := range transactions {
+		if _, ok := parent[transaction.ID]; !ok {
+			continue
+		}
+		root := find(transaction.ID)
+		group, ok := groupsByRoot[root]
+		if !ok {
+			group = &entities.DuplicateGroup{}
+			groupsByRoot[root] = group
+			groups = append(groups, group)
+		}
+		group.Transactions = append(group.Transactions, transaction)
+	}
+
+	// Grupos mais recentes primeiro
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Transactions[0].Date.After(groups[j].Transactions[0].Date)
+	})
+
+	return groups, nil
+}
+
+func (s *transactionServiceImpl) MergeDuplicates(ctx context.Context, userID, keepID uint, duplicateIDs []uint) (*entities.Transaction, error) {
+	if len(duplicateIDs) == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Informe as transações duplicadas")
+	}
+
+	keep, err := s.GetTransactionByID(ctx, userID, keepID)
+	if err != nil {
+		return nil, err
+	}
+	if keep.IsTransfer() {
+		return nil, pkgErrors.NewDomainError("validation_error", "Transferências não podem ser mescladas")
+	}
+
+	seen := map[uint]bool{keepID: true}
+	ids := make([]uint, 0, len(duplicateIDs))
+	tagIDs := append([]uint{}, keep.TagIDs...)
+
+	for _, duplicateID := range duplicateIDs {
+		if seen[duplicateID] {
+			if duplicateID == keepID {
+				return nil, pkgErrors.NewDomainError("validation_error", "A transação mantida não pode estar entre as duplicatas")
+			}
+			continue
+		}
+		seen[duplicateID] = true
+
+		duplicate, err := s.GetTransactionByID(ctx, userID, duplicateID)
+		if err != nil {
+			return nil, err
+		}
+		if duplicate.IsTransfer() {
+			return nil, pkgErrors.NewDomainError("validation_error", "Transferências não podem ser mescladas")
+		}
+
+		if keep.CategoryID == nil && !keep.HasSplits() && duplicate.CategoryID != nil {
+			keep.SetCategory(*duplicate.CategoryID)
+		}
+		if keep.PayeeID == nil && duplicate.PayeeID != nil {
+			keep.SetPayee(*duplicate.PayeeID)
+		}
+		if keep.Notes == "" {
+			keep.Notes = duplicate.Notes
+		}
+		tagIDs = append(tagIDs, duplicate.TagIDs...)
+		ids = append(ids, duplicateID)
+	}
+
+	keep.SetTags(tagIDs)
+
+	if err := s.transactionRepo.MergeDuplicates(ctx, keep, ids); err != nil {
+		return nil, err
+	}
+
+	return keep, nil
+}
+
+func (s *transactionServiceImpl) BulkUpdateTransactions(ctx context.Context, userID uint, transactionIDs []uint, operation *entities.BulkOperation) ([]*entities.BulkItemResult, error) {
+	if len(transactionIDs) == 0 {
+		return nil, pkgErrors.NewDomainError("validation_error", "Informe as transações")
+	}
+	if len(transactionIDs) > maxBulkItems {
+		return nil, pkgErrors.NewDomainError("validation_error", fmt.Sprintf("Informe no máximo %d transações por operação", maxBulkItems))
+	}
+	if err := s.validateBulkOperation(ctx, userID, operation); err != nil {
+		return nil, err
+	}
+
+	batch := &bulkBatch{staged: make(map[uint]bool)}
+	results := make([]*entities.BulkItemResult, 0, len(transactionIDs))
+	seen := make(map[uint]bool, len(transactionIDs))
+
+	for _, transactionID := range transactionIDs {
+		if seen[transactionID] {
+			continue
+		}
+		seen[transactionID] = true
+
+		result := &entities.BulkItemResult{TransactionID: transactionID}
+		results = append(results, result)
+
+		// A outra perna de uma transferência já incluída no lote
+		if batch.staged[transactionID] {
+			continue
+		}
+
+		result.Err = s.stageBulkItem(ctx, userID, transactionID, operation, batch)
+	}
+
+	if len(batch.updates) > 0 || len(batch.deleteIDs) > 0 {
+		if err := s.transactionRepo.ApplyBulk(ctx, userID, batch.updates, batch.deleteIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// bulkBatch acumula as alterações de uma operação em lote para gravá-las juntas
+type bulkBatch struct {
+	updates   []*entities.Transaction
+	deleteIDs []uint
+	staged    map[uint]bool
+}
+
+func (b *bulkBatch) update(transactions ...*entities.Transaction) {
+	for _, transaction := range transactions {
+		b.staged[transaction.ID] = true
+		b.updates = append(b.updates, transaction)
+	}
+}
+
+func (b *bulkBatch) delete(transactionIDs ...uint) {
+	for _, id := range transactionIDs {
+		b.staged[id] = true
+		b.deleteIDs = append(b.deleteIDs, id)
+	}
+}
+
+// validateBulkOperation valida uma única vez os dados comuns a todo o lote
+func (s *transactionServiceImpl) validateBulkOperation(ctx context.Context, userID uint, operation *entities.BulkOperation) error {
+	if !operation.Action.IsValid() {
+		return pkgErrors.NewDomainError("validation_error", "Ação em lote inválida")
+	}
+
+	switch operation.Action {
+	case entities.BulkSetCategory:
+		if operation.CategoryID == nil {
+			return pkgErrors.NewDomainError("validation_error", "Categoria é obrigatória para recategorizar")
+		}
+		category, err := s.categoryRepo.GetByID(ctx, *operation.CategoryID)
+		if err != nil {
+			return err
+		}
+		if !category.BelongsToUser(userID) {
+			return pkgErrors.ErrForbidden
+		}
+	case entities.BulkSetTags:
+		if operation.TagMode == "" {
+			operation.TagMode = entities.BulkTagsReplace
+		}
+		if operation.TagMode != entities.BulkTagsReplace && len(operation.TagIDs) == 0 {
+			return pkgErrors.NewDomainError("validation_error", "Informe as tags")
+		}
+		return s.validateTags(ctx, userID, operation.TagIDs)
+	case entities.BulkMoveAccount:
+		if operation.AccountID == nil {
+			return pkgErrors.NewDomainError("validation_error", "Conta é obrigatória para mover transações")
+		}
+		return s.validateAccount(ctx, userID, operation.AccountID)
+	}
+
+	return nil
+}
+
+// stageBulkItem aplica a operação a uma transação do usuário e a inclui no lote.
+// Transferências marcadas como pagas ou excluídas levam junto a outra perna.
+func (s *transactionServiceImpl) stageBulkItem(ctx context.Context, userID, transactionID uint, operation *entities.BulkOperation, batch *bulkBatch) error {
+	transaction, err := s.GetTransactionByID(ctx, userID, transactionID)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Action {
+	case entities.BulkMarkPaid, entities.BulkMarkUnpaid:
+		paid := operation.Action == entities.BulkMarkPaid
+		if !transaction.IsTransfer() {
+			transaction.Paid = paid
+			transaction.UpdatedAt = time.Now()
+			batch.update(transaction)
+			return nil
+		}
+		debit, credit, err := s.getTransferLegs(ctx, userID, transaction)
+		if err != nil {
+			return err
+		}
+		debit.Paid = paid
+		credit.Paid = paid
+		batch.update(debit, credit)
+
+	case entities.BulkSetCategory:
+		if transaction.IsTransfer() {
+			return pkgErrors.NewDomainError("validation_error", "Transferências não possuem categoria")
+		}
+		if transaction.HasSplits() {
+			return pkgErrors.NewDomainError("validation_error", "Transação dividida entre categorias não pode ser recategorizada em lote")
+		}
+		transaction.SetCategory(*operation.CategoryID)
+		batch.update(transaction)
+
+	case entities.BulkSetTags:
+		operation.ApplyTags(transaction)
+		batch.update(transaction)
+
+	case entities.BulkMoveAccount:
+		if transaction.IsTransfer() {
+			return pkgErrors.NewDomainError("validation_error", "As contas de uma transferência devem ser alteradas pela própria transferência")
+		}
+		transaction.SetAccount(*operation.AccountID)
+		// A nova conta pode ser um cartão com outra fatura
+		if err := s.assignInvoice(ctx, transaction); err != nil {
+			return err
+		}
+		batch.update(transaction)
+
+	case entities.BulkDelete:
+		if !transaction.IsTransfer() {
+			batch.delete(transaction.ID)
+			return nil
+		}
+		debit, credit, err := s.getTransferLegs(ctx, userID, transaction)
+		if err != nil {
+			return err
+		}
+		batch.delete(debit.ID, credit.ID)
+	}
+
+	return nil
+}
+
 func (s *transactionServiceImpl) TogglePaidStatus(ctx context.Context, userID, transactionID uint) (*entities.Transaction, error) {
 	// Buscar transação
 	transaction, err := s.GetTransactionByID(ctx, userID, transactionID)
@@ -143,6 +1123,19 @@ func (s *transactionServiceImpl) TogglePaidStatus(ctx context.Context, userID, t
 	// Alternar status de pagamento
 	transaction.TogglePaid()
 
+	if transaction.IsTransfer() {
+		debit, credit, err := s.getTransferLegs(ctx, userID, transaction)
+		if err != nil {
+			return nil, err
+		}
+		debit.Paid = transaction.Paid
+		credit.Paid = transaction.Paid
+		if err := s.transactionRepo.UpdateTransfer(ctx, debit, credit); err != nil {
+			return nil, err
+		}
+		return transaction, nil
+	}
+
 	// Salvar alteração
 	if err := s.transactionRepo.Update(ctx, transaction); err != nil {
 		return nil, err
@@ -179,10 +1172,21 @@ func (s *transactionServiceImpl) GetTransactionStats(ctx context.Context, userID
 		return nil, err
 	}
 
+	// Saldo corrente por conta até a data final do filtro
+	var until *time.Time
+	if endDate != nil && !endDate.IsZero() {
+		until = endDate
+	}
+	accountBalances, err := s.accountRepo.GetBalances(ctx, userID, until)
+	if err != nil {
+		return nil, err
+	}
+
 	stats["total_income"] = totalIncome
 	stats["total_expense"] = totalExpense
 	stats["total_investment"] = totalInvestment
 	stats["balance"] = totalIncome - totalExpense
+	stats["account_balances"] = accountBalances
 
 	return stats, nil
 }
@@ -194,6 +1198,8 @@ func (s *transactionServiceImpl) GetReports(ctx context.Context, userID uint, fi
 		"totalExpense":   0,
 		"balance":        0,
 		"categoryTotals": []map[string]interface{}{},
+		"tagTotals":      []map[string]interface{}{},
+		"payeeTotals":    []map[string]interface{}{},
 		"monthlyTotals":  []map[string]interface{}{},
 	}
 
@@ -264,6 +1270,44 @@ func (s *transactionServiceImpl) GetReports(ctx context.Context, userID uint, fi
 		)
 	}
 
+	// Buscar totais por tag
+	tagTotals, err := s.transactionRepo.GetTagTotals(ctx, userID, filters)
+	if err != nil {
+		log.Printf("Erro ao buscar totais por tag: %v", err)
+		return nil, err
+	}
+
+	for _, tag := range tagTotals {
+		reportData["tagTotals"] = append(
+			reportData["tagTotals"].([]map[string]interface{}),
+			map[string]interface{}{
+				"name":  tag.TagName,
+				"total": tag.Total,
+				"type":  tag.Type,
+			},
+		)
+	}
+
+	// Buscar totais por favorecido
+	payeeTotals, err := s.transactionRepo.GetPayeeTotals(ctx, userID, filters)
+	if err != nil {
+		log.Printf("Erro ao buscar totais por favorecido: %v", err)
+		return nil, err
+	}
+
+	for _, payee := range payeeTotals {
+		reportData["payeeTotals"] = append(
+			reportData["payeeTotals"].([]map[string]interface{}),
+			map[string]interface{}{
+				"id":    payee.PayeeID,
+				"name":  payee.PayeeName,
+				"total": payee.Total,
+				"count": payee.Count,
+				"type":  payee.Type,
+			},
+		)
+	}
+
 	// Estatísticas mensais do ano atual
 	monthlyStats, err := s.transactionRepo.GetMonthlyStats(ctx, userID, currentYear, nil, nil)
 	if err != nil {
@@ -296,6 +1340,7 @@ func (s *transactionServiceImpl) GetDashboardReports(ctx context.Context, userID
 		return nil, err
 	}
 	reports["stats"] = stats
+	reports["accounts"] = stats["account_balances"]
 
 	// Transações recentes (últimas 10)
 	recentTransactions, err := s.GetTransactionsByUser(ctx, userID, nil)
@@ -317,13 +1362,140 @@ func (s *transactionServiceImpl) GetDashboardReports(ctx context.Context, userID
 	}
 	reports["monthly_stats"] = monthlyStats
 
+	// Planejado x realizado dos orçamentos do mês atual
+	now := time.Now()
+	budgetStatuses, err := s.budgetService.GetBudgetStatus(ctx, userID, now.Year(), int(now.Month()))
+	if err != nil {
+		return nil, err
+	}
+	budgets := make([]map[string]interface{}, len(budgetStatuses))
+	for i, status := range budgetStatuses {
+		budgets[i] = map[string]interface{}{
+			"budget_id":     status.Budget.ID,
+			"category_id":   status.Budget.CategoryID,
+			"category_name": status.CategoryName,
+			"planned":       status.Planned,
+			"carryover":     status.Carryover,
+			"available":     status.Available,
+			"actual":        status.Actual,
+			"remaining":     status.Remaining,
+		}
+	}
+	reports["budgets"] = budgets
+
 	return reports, nil
 }
 
 func (s *transactionServiceImpl) CreateRecurringTransactions(ctx context.Context, transaction *entities.Transaction) error {
-	// TODO: Implementar lógica para criar transações recorrentes
-	// Esta funcionalidade pode ser implementada posteriormente
-	return pkgErrors.NewDomainError("not_implemented", "Funcionalidade de transações recorrentes ainda não implementada")
+	if !transaction.IsRecurrenceTemplate() || transaction.ID == 0 {
+		return pkgErrors.NewDomainError("validation_error", "Transação não é um modelo de recorrência válido")
+	}
+
+	// Datas já geradas, para que a execução seja idempotente
+	existingDates, err := s.transactionRepo.GetOccurrenceDates(ctx, transaction.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.generateOccurrences(ctx, transaction, existingDates)
+}
+
+// recurrenceLimit retorna a data limite de geração da série: o fim da recorrência
+// ou o horizonte móvel, o que vier primeiro
+func recurrenceLimit(template *entities.Transaction) time.Time {
+	limit := time.Now().AddDate(0, recurrenceHorizonMonths, 0)
+	if template.RecurrenceEnd != nil && template.RecurrenceEnd.Before(limit) {
+		limit = *template.RecurrenceEnd
+	}
+	return limit
+}
+
+// newOccurrences monta as ocorrências das datas informadas, já com a fatura definida
+func (s *transactionServiceImpl) newOccurrences(ctx context.Context, template *entities.Transaction, dates []time.Time) ([]*entities.Transaction, error) {
+	occurrences := make([]*entities.Transaction, len(dates))
+	for i, date := range dates {
+		occurrences[i] = template.NewOccurrence(date)
+		if err := s.assignInvoice(ctx, occurrences[i]); err != nil {
+			return nil, err
+		}
+	}
+	return occurrences, nil
+}
+
+// generateOccurrences grava as próximas ocorrências da série depois das já geradas,
+// criando também o modelo quando ele ainda não tem ID
+func (s *transactionServiceImpl) generateOccurrences(ctx context.Context, template *entities.Transaction, existingDates []time.Time) error {
+	dates := template.PendingOccurrenceDates(existingDates, time.Time{}, recurrenceLimit(template), recurrenceMaxOccurrencesPerRun)
+	if len(dates) == 0 && template.ID != 0 {
+		return nil
+	}
+
+	occurrences, err := s.newOccurrences(ctx, template, dates)
+	if err != nil {
+		return err
+	}
+
+	created, err := s.transactionRepo.CreateOccurrences(ctx, template, occurrences)
+	if err != nil {
+		return err
+	}
+
+	if created > 0 {
+		log.Printf("Recorrência - Modelo ID: %d, ocorrências geradas: %d", template.ID, created)
+	}
+
+	return nil
+}
+
+// reconcileOccurrences grava o modelo alterado e atualiza as ocorrências não pagas a
+// partir de hoje conforme a nova configuração; ocorrências passadas, pagas, editadas
+// pelo usuário ou com anexos são mantidas. Sem recorrência, as ocorrências futuras
+// que ainda são cópias do modelo são apenas removidas.
+func (s *transactionServiceImpl) reconcileOccurrences(ctx context.Context, previous, template *entities.Transaction) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	dates := template.PendingOccurrenceDates(nil, today, recurrenceLimit(template), recurrenceMaxOccurrencesPerRun)
+	occurrences, err := s.newOccurrences(ctx, template, dates)
+	if err != nil {
+		return err
+	}
+
+	return s.transactionRepo.UpdateRecurrence(ctx, previous, template, today, occurrences)
+}
+
+func (s *transactionServiceImpl) GenerateRecurringTransactions(ctx context.Context, userID uint) error {
+	templates, err := s.transactionRepo.GetRecurringTransactions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templates {
+		if err := s.CreateRecurringTransactions(ctx, template); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *transactionServiceImpl) GenerateAllRecurringTransactions(ctx context.Context) error {
+	templates, err := s.transactionRepo.GetAllRecurringTransactions(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Uma série com erro não deve impedir a geração das demais
+	var firstErr error
+	for _, template := range templates {
+		if err := s.CreateRecurringTransactions(ctx, template); err != nil {
+			log.Printf("Erro ao gerar recorrência - Modelo ID: %d: %v", template.ID, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
 }
 
 func (s *transactionServiceImpl) GetMonthlyStats(ctx context.Context, userID uint, year int, startDate, endDate *time.Time) ([]repositories.MonthlyStats, error) {
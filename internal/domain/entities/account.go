@@ -0,0 +1,124 @@
+package entities
+
+import "time"
+
+type AccountKind string
+
+const (
+	CHECKING           AccountKind = "checking"
+	SAVINGS            AccountKind = "savings"
+	CASH               AccountKind = "cash"
+	CREDIT_CARD        AccountKind = "credit_card"
+	INVESTMENT_ACCOUNT AccountKind = "investment"
+)
+
+// DefaultCurrency é a moeda usada quando nenhuma é informada
+const DefaultCurrency = "BRL"
+
+type Account struct {
+	ID             uint
+	Name           string
+	Kind           AccountKind
+	Institution    string
+	OpeningBalance float64
+	Currency       string
+	Archived       bool
+	ClosingDay     int
+	DueDay         int
+	UserID         uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewAccount creates a new Account entity
+func NewAccount(name string, kind AccountKind, institution string, openingBalance float64, currency string, userID uint) *Account {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	return &Account{
+		Name:           name,
+		Kind:           kind,
+		Institution:    institution,
+		OpeningBalance: openingBalance,
+		Currency:       currency,
+		UserID:         userID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// IsValid verifica se o tipo de conta é suportado
+func (k AccountKind) IsValid() bool {
+	switch k {
+	case CHECKING, SAVINGS, CASH, CREDIT_CARD, INVESTMENT_ACCOUNT:
+		return true
+	default:
+		return false
+	}
+}
+
+// Update atualiza os dados da conta
+func (a *Account) Update(name string, kind AccountKind, institution string, openingBalance float64, currency string) {
+	a.Name = name
+	a.Kind = kind
+	a.Institution = institution
+	a.OpeningBalance = openingBalance
+	if currency != "" {
+		a.Currency = currency
+	}
+	a.UpdatedAt = time.Now()
+}
+
+// SetCardCycle define os dias de fechamento e vencimento da fatura do cartão
+func (a *Account) SetCardCycle(closingDay, dueDay int) {
+	a.ClosingDay = closingDay
+	a.DueDay = dueDay
+	a.UpdatedAt = time.Now()
+}
+
+// IsCreditCard verifica se a conta é um cartão de crédito
+func (a *Account) IsCreditCard() bool {
+	return a.Kind == CREDIT_CARD
+}
+
+// InvoiceDatesFor retorna as datas de fechamento e vencimento da fatura em que
+// uma compra feita na data informada é lançada. Compras a partir do dia de
+// fechamento entram na fatura seguinte; dias inexistentes no mês são ajustados
+// para o último dia.
+func (a *Account) InvoiceDatesFor(date time.Time) (time.Time, time.Time) {
+	closingMonth := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	if date.Day() >= dayInMonth(closingMonth, a.ClosingDay).Day() {
+		closingMonth = closingMonth.AddDate(0, 1, 0)
+	}
+	closingDate := dayInMonth(closingMonth, a.ClosingDay)
+
+	// O vencimento é no mesmo mês do fechamento quando o dia de vencimento é posterior
+	dueMonth := closingMonth
+	if a.DueDay <= a.ClosingDay {
+		dueMonth = dueMonth.AddDate(0, 1, 0)
+	}
+	dueDate := dayInMonth(dueMonth, a.DueDay)
+
+	return closingDate, dueDate
+}
+
+// dayInMonth retorna o dia informado no mês de firstOfMonth, limitado ao último dia do mês
+func dayInMonth(firstOfMonth time.Time, day int) time.Time {
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// SetArchived arquiva ou reativa a conta
+func (a *Account) SetArchived(archived bool) {
+	a.Archived = archived
+	a.UpdatedAt = time.Now()
+}
+
+// BelongsToUser verifica se a conta pertence ao usuário
+func (a *Account) BelongsToUser(userID uint) bool {
+	return a.UserID == userID
+}
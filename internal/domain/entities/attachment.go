@@ -0,0 +1,56 @@
+package entities
+
+import "time"
+
+// Tipos de arquivo aceitos como anexo e a extensão usada ao armazená-los
+var attachmentExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+// Attachment representa um comprovante (nota, recibo) anexado a uma transação
+type Attachment struct {
+	ID            uint
+	TransactionID uint
+	UserID        uint
+	FileName      string
+	ContentType   string
+	Size          int64
+	StorageKey    string
+	ThumbnailKey  string
+	CreatedAt     time.Time
+}
+
+// NewAttachment creates a new Attachment entity
+func NewAttachment(transactionID, userID uint, fileName, contentType string, size int64) *Attachment {
+	return &Attachment{
+		TransactionID: transactionID,
+		UserID:        userID,
+		FileName:      fileName,
+		ContentType:   contentType,
+		Size:          size,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// AttachmentExtension retorna a extensão do tipo de arquivo e se ele é aceito como anexo
+func AttachmentExtension(contentType string) (string, bool) {
+	ext, ok := attachmentExtensions[contentType]
+	return ext, ok
+}
+
+// IsImage verifica se o anexo é uma imagem
+func (a *Attachment) IsImage() bool {
+	return a.ContentType == "image/jpeg" || a.ContentType == "image/png"
+}
+
+// HasThumbnail verifica se o anexo possui miniatura
+func (a *Attachment) HasThumbnail() bool {
+	return a.ThumbnailKey != ""
+}
+
+// BelongsToUser verifica se o anexo pertence ao usuário
+func (a *Attachment) BelongsToUser(userID uint) bool {
+	return a.UserID == userID
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+// Budget é o valor planejado por mês para os gastos de uma categoria, a partir
+// do mês inicial. Com Rollover, o que sobra em um mês é somado ao seguinte.
+type Budget struct {
+	ID         uint
+	UserID     uint
+	CategoryID uint
+	Amount     float64
+	Rollover   bool
+	StartMonth time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// BudgetStatus compara o planejado com o realizado de um orçamento em um mês
+type BudgetStatus struct {
+	Budget       *Budget
+	CategoryName string
+	Month        time.Time
+	Planned      float64
+	Carryover    float64
+	Available    float64
+	Actual       float64
+	Remaining    float64
+}
+
+// NewBudget creates a new Budget entity
+func NewBudget(categoryID uint, amount float64, rollover bool, startMonth time.Time, userID uint) *Budget {
+	return &Budget{
+		UserID:     userID,
+		CategoryID: categoryID,
+		Amount:     amount,
+		Rollover:   rollover,
+		StartMonth: MonthStart(startMonth),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+// Update atualiza os dados do orçamento
+func (b *Budget) Update(amount float64, rollover bool, startMonth time.Time) {
+	b.Amount = amount
+	b.Rollover = rollover
+	b.StartMonth = MonthStart(startMonth)
+	b.UpdatedAt = time.Now()
+}
+
+// BelongsToUser verifica se o orçamento pertence ao usuário
+func (b *Budget) BelongsToUser(userID uint) bool {
+	return b.UserID == userID
+}
+
+// IsActiveIn verifica se o orçamento já vale no mês informado
+func (b *Budget) IsActiveIn(month time.Time) bool {
+	return !MonthStart(month).Before(b.StartMonth)
+}
+
+// Carryover calcula o saldo não gasto acumulado dos meses anteriores a month.
+// spent traz o realizado por mês, indexado por MonthKey. Estouros não são
+// levados adiante: um mês acima do orçamento zera o acumulado.
+func (b *Budget) Carryover(month time.Time, spent map[string]float64) float64 {
+	if !b.Rollover {
+		return 0
+	}
+
+	carry := 0.0
+	month = MonthStart(month)
+	for m := b.StartMonth; m.Before(month); m = m.AddDate(0, 1, 0) {
+		carry = math.Max(0, carry+b.Amount-spent[MonthKey(m)])
+	}
+	return carry
+}
+
+// Status monta a comparação do orçamento no mês a partir do realizado e do acumulado
+func (b *Budget) Status(month time.Time, actual, carryover float64) *BudgetStatus {
+	available := b.Amount + carryover
+	return &BudgetStatus{
+		Budget:    b,
+		Month:     MonthStart(month),
+		Planned:   b.Amount,
+		Carryover: carryover,
+		Available: available,
+		Actual:    actual,
+		Remaining: available - actual,
+	}
+}
+
+// MonthStart retorna o primeiro dia do mês de t, em UTC
+func MonthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// MonthKey identifica o mês de t no formato AAAA-MM
+func MonthKey(t time.Time) string {
+	return t.Format("2006-01")
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBudgetCarryover(t *testing.T) {
+	spent := map[string]float64{
+		"2026-01": 60,
+		"2026-02": 150,
+		"2026-03": 80,
+	}
+
+	tests := []struct {
+		name     string
+		rollover bool
+		month    time.Time
+		want     float64
+	}{
+		{name: "primeiro mês", rollover: true, month: date(2026, 1, 15), want: 0},
+		{name: "sobra do mês anterior", rollover: true, month: date(2026, 2, 1), want: 40},
+		{name: "estouro zera o acumulado", rollover: true, month: date(2026, 3, 1), want: 0},
+		{name: "volta a acumular", rollover: true, month: date(2026, 4, 1), want: 20},
+		{name: "meses sem gastos", rollover: true, month: date(2026, 6, 1), want: 220},
+		{name: "antes do início", rollover: true, month: date(2025, 12, 1), want: 0},
+		{name: "sem acumular", rollover: false, month: date(2026, 4, 1), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := NewBudget(1, 100, tt.rollover, date(2026, 1, 1), 1)
+			if got := budget.Carryover(tt.month, spent); got != tt.want {
+				t.Errorf("Carryover(%s) = %v, esperava %v", MonthKey(tt.month), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBudgetStatus(t *testing.T) {
+	budget := NewBudget(1, 100, true, date(2026, 1, 1), 1)
+	status := budget.Status(date(2026, 4, 20), 130, 20)
+
+	if !status.Month.Equal(date(2026, 4, 1)) {
+		t.Errorf("Month = %s, esperava 2026-04-01", status.Month)
+	}
+	if status.Planned != 100 || status.Carryover != 20 || status.Available != 120 || status.Actual != 130 || status.Remaining != -10 {
+		t.Errorf("situação inesperada: %+v", status)
+	}
+}
+
+func TestBudgetIsActiveIn(t *testing.T) {
+	budget := NewBudget(1, 100, false, date(2026, 3, 20), 1)
+
+	for month, want := range map[time.Time]bool{
+		date(2026, 2, 28): false,
+		date(2026, 3, 1):  true,
+		date(2026, 3, 31): true,
+		date(2027, 1, 1):  true,
+	} {
+		if got := budget.IsActiveIn(month); got != want {
+			t.Errorf("IsActiveIn(%s) = %v, esperava %v", month.Format("2006-01-02"), got, want)
+		}
+	}
+}
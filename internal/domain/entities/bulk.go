@@ -0,0 +1,69 @@
+package entities
+
+// BulkAction identifica a alteração aplicada a um lote de transações
+type BulkAction string
+
+const (
+	BulkMarkPaid    BulkAction = "mark_paid"
+	BulkMarkUnpaid  BulkAction = "mark_unpaid"
+	BulkSetCategory BulkAction = "set_category"
+	BulkSetTags     BulkAction = "set_tags"
+	BulkMoveAccount BulkAction = "move_account"
+	BulkDelete      BulkAction = "delete"
+)
+
+// IsValid verifica se a ação em lote é conhecida
+func (a BulkAction) IsValid() bool {
+	switch a {
+	case BulkMarkPaid, BulkMarkUnpaid, BulkSetCategory, BulkSetTags, BulkMoveAccount, BulkDelete:
+		return true
+	}
+	return false
+}
+
+// BulkTagMode define como as tags informadas são combinadas com as atuais
+type BulkTagMode string
+
+const (
+	BulkTagsReplace BulkTagMode = "replace"
+	BulkTagsAdd     BulkTagMode = "add"
+	BulkTagsRemove  BulkTagMode = "remove"
+)
+
+// BulkOperation descreve a alteração aplicada a todas as transações do lote
+type BulkOperation struct {
+	Action     BulkAction
+	CategoryID *uint
+	TagIDs     []uint
+	TagMode    BulkTagMode
+	AccountID  *uint
+}
+
+// BulkItemResult é o resultado da operação para uma transação do lote;
+// Err fica nil quando a transação foi alterada
+type BulkItemResult struct {
+	TransactionID uint
+	Err           error
+}
+
+// ApplyTags combina as tags da transação com as da operação conforme o modo
+func (o *BulkOperation) ApplyTags(t *Transaction) {
+	switch o.TagMode {
+	case BulkTagsAdd:
+		t.SetTags(append(append([]uint{}, t.TagIDs...), o.TagIDs...))
+	case BulkTagsRemove:
+		remove := make(map[uint]bool, len(o.TagIDs))
+		for _, id := range o.TagIDs {
+			remove[id] = true
+		}
+		kept := make([]uint, 0, len(t.TagIDs))
+		for _, id := range t.TagIDs {
+			if !remove[id] {
+				kept = append(kept, id)
+			}
+		}
+		t.SetTags(kept)
+	default:
+		t.SetTags(o.TagIDs)
+	}
+}
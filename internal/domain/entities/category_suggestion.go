@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"strings"
+	"unicode"
+)
+
+// CategorySuggestion é uma categoria sugerida para uma descrição, com a confiança (0 a 1)
+// estimada a partir do histórico do usuário
+type CategorySuggestion struct {
+	Category   *Category
+	Confidence float64
+}
+
+// DescriptionTokens extrai as palavras da descrição usadas para sugerir categorias.
+// Palavras com dígitos (datas, códigos de autorização, parcelas) e com menos de
+// duas letras são descartadas, e cada palavra conta uma única vez.
+func DescriptionTokens(description string) []string {
+	words := strings.Fields(NormalizeDescription(description))
+
+	tokens := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		if len(word) < 2 || seen[word] || strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+			continue
+		}
+		seen[word] = true
+		tokens = append(tokens, word)
+	}
+
+	return tokens
+}
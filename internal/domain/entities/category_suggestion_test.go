@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDescriptionTokens(t *testing.T) {
+	tests := []struct {
+		description string
+		want        []string
+	}{
+		{description: "Padaria São João", want: []string{"padaria", "sao", "joao"}},
+		{description: "PAG*IFOOD  SP", want: []string{"pag", "ifood", "sp"}},
+		{description: "Uber 12/03 AUT123456", want: []string{"uber"}},
+		{description: "Netflix (3/12)", want: []string{"netflix"}},
+		{description: "posto e posto", want: []string{"posto"}},
+		{description: "  ", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := DescriptionTokens(tt.description); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DescriptionTokens(%q) = %v, esperava %v", tt.description, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package entities
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// DuplicateDateWindow é a distância máxima entre as datas de duas transações
+	// para que sejam consideradas possíveis duplicatas (compensação bancária, fuso etc.)
+	DuplicateDateWindow = 3 * 24 * time.Hour
+	// DuplicateSimilarityThreshold é a semelhança mínima entre as descrições normalizadas
+	DuplicateSimilarityThreshold = 0.6
+)
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// DuplicateGroup reúne transações que provavelmente representam o mesmo lançamento
+type DuplicateGroup struct {
+	Transactions []*Transaction
+}
+
+// DuplicateWarning monta o aviso exibido quando há possíveis duplicatas
+func DuplicateWarning(candidateIDs []uint) string {
+	ids := make([]string, len(candidateIDs))
+	for i, id := range candidateIDs {
+		ids[i] = strconv.FormatUint(uint64(id), 10)
+	}
+	return "possível duplicata da(s) transação(ões) " + strings.Join(ids, ", ")
+}
+
+// NormalizeDescription deixa apenas letras e números em minúsculas, sem acentos e
+// com espaços simples, para comparar descrições digitadas de formas diferentes
+func NormalizeDescription(description string) string {
+	description = accentReplacer.Replace(strings.ToLower(description))
+
+	var builder strings.Builder
+	space := false
+	for _, r := range description {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && builder.Len() > 0 {
+				builder.WriteByte(' ')
+			}
+			builder.WriteRune(r)
+			space = false
+		} else {
+			space = true
+		}
+	}
+	return builder.String()
+}
+
+// DescriptionSimilarity compara as descrições pelo coeficiente de Dice dos pares de
+// letras (0 a 1). Uma descrição contida na outra, como "ifood" em "pag ifood sp",
+// é considerada igual.
+func DescriptionSimilarity(a, b string) float64 {
+	a, b = NormalizeDescription(a), NormalizeDescription(b)
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+
+	shorter, longer := a, b
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	if len(shorter) >= 4 && strings.Contains(longer, shorter) {
+		return 1
+	}
+
+	bigramsA, bigramsB := bigrams(a), bigrams(b)
+	total := 0
+	for _, count := range bigramsA {
+		total += count
+	}
+	for _, count := range bigramsB {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	common := 0
+	for bigram, countA := range bigramsA {
+		if countB, ok := bigramsB[bigram]; ok {
+			if countA < countB {
+				common += countA
+			} else {
+				common += countB
+			}
+		}
+	}
+
+	return 2 * float64(common) / float64(total)
+}
+
+func bigrams(value string) map[string]int {
+	runes := []rune(strings.ReplaceAll(value, " ", ""))
+	result := make(map[string]int, len(runes))
+	for i := 0; i+1 < len(runes); i++ {
+		result[string(runes[i:i+2])]++
+	}
+	return result
+}
+
+// IsLikelyDuplicateOf verifica se a transação parece ser o mesmo lançamento que
+// other: mesmo usuário, tipo e valor, datas próximas e descrições semelhantes.
+// Transferências e transações da mesma série (parcelas, recorrências) nunca são duplicatas.
+func (t *Transaction) IsLikelyDuplicateOf(other *Transaction) bool {
+	if t.ID != 0 && t.ID == other.ID {
+		return false
+	}
+	if t.UserID != other.UserID || t.Type != other.Type || t.IsTransfer() || other.IsTransfer() {
+		return false
+	}
+	if math.Round(t.Amount*100) != math.Round(other.Amount*100) {
+		return false
+	}
+	if t.sameSeries(other) {
+		return false
+	}
+
+	diff := t.Date.Sub(other.Date)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > DuplicateDateWindow {
+		return false
+	}
+
+	return DescriptionSimilarity(t.Description, other.Description) >= DuplicateSimilarityThreshold
+}
+
+// sameSeries verifica se as transações pertencem à mesma compra parcelada ou série recorrente
+func (t *Transaction) sameSeries(other *Transaction) bool {
+	if t.ParentID == nil && other.ParentID == nil {
+		return false
+	}
+	return t.InstallmentGroupID() == other.InstallmentGroupID()
+}
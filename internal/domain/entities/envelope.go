@@ -0,0 +1,257 @@
+package entities
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// EnvelopeEntryKind identifica a origem de um lançamento no razão de envelopes
+type EnvelopeEntryKind string
+
+const (
+	// EnvelopeAssign move dinheiro de "a atribuir" para um envelope (negativo devolve)
+	EnvelopeAssign EnvelopeEntryKind = "assign"
+	// EnvelopeMove registra cada lado de uma transferência entre envelopes
+	EnvelopeMove EnvelopeEntryKind = "move"
+	// EnvelopeCarryover leva o saldo de um mês para o seguinte, inclusive estouros
+	EnvelopeCarryover EnvelopeEntryKind = "carryover"
+)
+
+// EnvelopeMaxMonthsAhead limita quantos meses depois do atual podem ser consultados
+// ou planejados, para que o cálculo e o razão não cresçam sem limite
+const EnvelopeMaxMonthsAhead = 12
+
+// EnvelopeHorizon retorna o último mês aceito pelo orçamento por envelopes em now
+func EnvelopeHorizon(now time.Time) time.Time {
+	return MonthStart(now).AddDate(0, EnvelopeMaxMonthsAhead, 0)
+}
+
+// EnvelopeSettings indica que o usuário usa orçamento por envelopes a partir de StartMonth;
+// receitas anteriores não entram no valor a atribuir
+type EnvelopeSettings struct {
+	UserID     uint
+	StartMonth time.Time
+	CreatedAt  time.Time
+}
+
+// EnvelopeEntry é um lançamento do razão de envelopes. CategoryID nil representa
+// o valor "a atribuir" do mês. Lançamentos nunca são alterados: correções viram novos lançamentos.
+type EnvelopeEntry struct {
+	ID                uint
+	UserID            uint
+	CategoryID        *uint
+	RelatedCategoryID *uint
+	Month             time.Time
+	Kind              EnvelopeEntryKind
+	Amount            float64
+	Note              string
+	CreatedAt         time.Time
+}
+
+// EnvelopeBalance é a situação de um envelope em um mês
+type EnvelopeBalance struct {
+	CategoryID   uint
+	CategoryName string
+	Carryover    float64
+	Assigned     float64
+	Moved        float64
+	Activity     float64
+	Available    float64
+}
+
+// EnvelopeMonth é a situação de todos os envelopes em um mês
+type EnvelopeMonth struct {
+	Month               time.Time
+	CarriedToBeAssigned float64
+	Income              float64
+	Assigned            float64
+	ToBeAssigned        float64
+	Envelopes           []*EnvelopeBalance
+}
+
+// EnvelopeActivity traz o que vem das transações, indexado por MonthKey: receitas
+// do mês e despesas por categoria
+type EnvelopeActivity struct {
+	Income map[string]float64
+	Spent  map[string]map[uint]float64
+}
+
+// NewEnvelopeSettings creates a new EnvelopeSettings entity
+func NewEnvelopeSettings(userID uint, startMonth time.Time) *EnvelopeSettings {
+	return &EnvelopeSettings{
+		UserID:     userID,
+		StartMonth: MonthStart(startMonth),
+		CreatedAt:  time.Now(),
+	}
+}
+
+// NewEnvelopeAssignment cria o lançamento de atribuição de amount ao envelope
+func NewEnvelopeAssignment(userID, categoryID uint, month time.Time, amount float64, note string) *EnvelopeEntry {
+	return &EnvelopeEntry{
+		UserID:     userID,
+		CategoryID: &categoryID,
+		Month:      MonthStart(month),
+		Kind:       EnvelopeAssign,
+		Amount:     amount,
+		Note:       note,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// NewEnvelopeMove cria os dois lados de uma transferência entre envelopes
+func NewEnvelopeMove(userID, fromCategoryID, toCategoryID uint, month time.Time, amount float64, note string) []*EnvelopeEntry {
+	out := &EnvelopeEntry{
+		UserID:            userID,
+		CategoryID:        &fromCategoryID,
+		RelatedCategoryID: &toCategoryID,
+		Month:             MonthStart(month),
+		Kind:              EnvelopeMove,
+		Amount:            -amount,
+		Note:              note,
+		CreatedAt:         time.Now(),
+	}
+	in := *out
+	in.CategoryID, in.RelatedCategoryID = &toCategoryID, &fromCategoryID
+	in.Amount = amount
+	return []*EnvelopeEntry{out, &in}
+}
+
+// ComputeEnvelopeMonths calcula mês a mês, de start até end, o valor a atribuir e os
+// saldos dos envelopes a partir das atribuições e transferências do razão e das
+// transações. Os saldos passam integralmente ao mês seguinte, positivos ou negativos;
+// os lançamentos de carryover gravados não entram no cálculo, apenas o espelham.
+func ComputeEnvelopeMonths(start, end time.Time, envelopeIDs []uint, entries []*EnvelopeEntry, activity *EnvelopeActivity) []*EnvelopeMonth {
+	start, end = MonthStart(start), MonthStart(end)
+
+	assigned := make(map[string]map[uint]float64)
+	moved := make(map[string]map[uint]float64)
+	ids := make(map[uint]bool, len(envelopeIDs))
+	for _, id := range envelopeIDs {
+		ids[id] = true
+	}
+
+	for _, entry := range entries {
+		if entry.CategoryID == nil || entry.Kind == EnvelopeCarryover {
+			continue
+		}
+		key := MonthKey(entry.Month)
+		target := assigned
+		if entry.Kind == EnvelopeMove {
+			target = moved
+		}
+		if target[key] == nil {
+			target[key] = make(map[uint]float64)
+		}
+		target[key][*entry.CategoryID] += entry.Amount
+		ids[*entry.CategoryID] = true
+	}
+	for _, spent := range activity.Spent {
+		for id := range spent {
+			ids[id] = true
+		}
+	}
+
+	sortedIDs := make([]uint, 0, len(ids))
+	for id := range ids {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Slice(sortedIDs, func(i, j int) bool { return sortedIDs[i] < sortedIDs[j] })
+
+	var months []*EnvelopeMonth
+	carry := make(map[uint]float64)
+	carriedToBeAssigned := 0.0
+
+	for m := start; !m.After(end); m = m.AddDate(0, 1, 0) {
+		key := MonthKey(m)
+		month := &EnvelopeMonth{
+			Month:               m,
+			CarriedToBeAssigned: carriedToBeAssigned,
+			Income:              activity.Income[key],
+			Envelopes:           make([]*EnvelopeBalance, len(sortedIDs)),
+		}
+
+		for i, id := range sortedIDs {
+			balance := &EnvelopeBalance{
+				CategoryID: id,
+				Carryover:  carry[id],
+				Assigned:   assigned[key][id],
+				Moved:      moved[key][id],
+				Activity:   0 - activity.Spent[key][id],
+			}
+			balance.Available = roundCents(balance.Carryover + balance.Assigned + balance.Moved + balance.Activity)
+			month.Assigned += balance.Assigned
+			month.Envelopes[i] = balance
+			carry[id] = balance.Available
+		}
+
+		month.Assigned = roundCents(month.Assigned)
+		month.ToBeAssigned = roundCents(month.CarriedToBeAssigned + month.Income - month.Assigned)
+		carriedToBeAssigned = month.ToBeAssigned
+		months = append(months, month)
+	}
+
+	return months
+}
+
+// CarryoverAdjustments compara os saldos levados de um mês ao outro em months com os
+// lançamentos de carryover já gravados e retorna os lançamentos que faltam para que o
+// razão reflita o cálculo, como quando uma transação de um mês passado é alterada
+func CarryoverAdjustments(userID uint, months []*EnvelopeMonth, entries []*EnvelopeEntry) []*EnvelopeEntry {
+	type carryKey struct {
+		month      string
+		categoryID uint
+		pool       bool
+	}
+
+	recorded := make(map[carryKey]float64)
+	for _, entry := range entries {
+		if entry.Kind != EnvelopeCarryover {
+			continue
+		}
+		key := carryKey{month: MonthKey(entry.Month), pool: entry.CategoryID == nil}
+		if entry.CategoryID != nil {
+			key.categoryID = *entry.CategoryID
+		}
+		recorded[key] += entry.Amount
+	}
+
+	var adjustments []*EnvelopeEntry
+	add := func(month time.Time, categoryID *uint, expected float64, key carryKey) {
+		if delta := roundCents(expected - recorded[key]); delta != 0 {
+			adjustments = append(adjustments, &EnvelopeEntry{
+				UserID:     userID,
+				CategoryID: categoryID,
+				Month:      month,
+				Kind:       EnvelopeCarryover,
+				Amount:     delta,
+				CreatedAt:  time.Now(),
+			})
+		}
+	}
+
+	for _, month := range months {
+		key := MonthKey(month.Month)
+		add(month.Month, nil, month.CarriedToBeAssigned, carryKey{month: key, pool: true})
+		for _, balance := range month.Envelopes {
+			categoryID := balance.CategoryID
+			add(month.Month, &categoryID, balance.Carryover, carryKey{month: key, categoryID: categoryID})
+		}
+	}
+
+	return adjustments
+}
+
+// Envelope retorna o saldo do envelope no mês, ou nil se a categoria não tiver envelope
+func (m *EnvelopeMonth) Envelope(categoryID uint) *EnvelopeBalance {
+	for _, balance := range m.Envelopes {
+		if balance.CategoryID == categoryID {
+			return balance
+		}
+	}
+	return nil
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
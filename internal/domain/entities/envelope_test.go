@@ -0,0 +1,145 @@
+package entities
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// envelopeLedger monta o razão usado nos testes: atribuições em janeiro e uma
+// transferência de 50 do envelope 1 para o 2 em fevereiro
+func envelopeLedger() []*EnvelopeEntry {
+	entries := []*EnvelopeEntry{
+		NewEnvelopeAssignment(1, 1, date(2026, 1, 10), 300, ""),
+		NewEnvelopeAssignment(1, 2, date(2026, 1, 10), 100, ""),
+	}
+	return append(entries, NewEnvelopeMove(1, 1, 2, date(2026, 2, 5), 50, "")...)
+}
+
+func envelopeActivity(spentJanuary float64) *EnvelopeActivity {
+	return &EnvelopeActivity{
+		Income: map[string]float64{"2026-01": 1000, "2026-02": 500},
+		Spent: map[string]map[uint]float64{
+			"2026-01": {1: spentJanuary, 2: 150},
+			"2026-02": {1: 30},
+			"2026-03": {3: 10},
+		},
+	}
+}
+
+func TestComputeEnvelopeMonths(t *testing.T) {
+	// Um lançamento de carryover gravado não altera o cálculo
+	carryover := uint(1)
+	entries := append(envelopeLedger(), &EnvelopeEntry{CategoryID: &carryover, Month: date(2026, 2, 1), Kind: EnvelopeCarryover, Amount: 999})
+
+	months := ComputeEnvelopeMonths(date(2026, 1, 20), date(2026, 3, 5), []uint{1, 2}, entries, envelopeActivity(120))
+	if len(months) != 3 {
+		t.Fatalf("esperava 3 meses, obteve %d", len(months))
+	}
+
+	tests := []struct {
+		month        int
+		toBeAssigned float64
+		assigned     float64
+		envelopes    map[uint][4]float64 // carryover, assigned, moved, available
+	}{
+		{month: 0, toBeAssigned: 600, assigned: 400, envelopes: map[uint][4]float64{
+			1: {0, 300, 0, 180},
+			2: {0, 100, 0, -50},
+			3: {0, 0, 0, 0},
+		}},
+		{month: 1, toBeAssigned: 1100, envelopes: map[uint][4]float64{
+			1: {180, 0, -50, 100},
+			2: {-50, 0, 50, 0},
+			3: {0, 0, 0, 0},
+		}},
+		{month: 2, toBeAssigned: 1100, envelopes: map[uint][4]float64{
+			1: {100, 0, 0, 100},
+			2: {0, 0, 0, 0},
+			3: {0, 0, 0, -10},
+		}},
+	}
+
+	for _, tt := range tests {
+		month := months[tt.month]
+		if want := date(2026, time.Month(tt.month+1), 1); !month.Month.Equal(want) {
+			t.Errorf("mês %d = %s, esperava %s", tt.month, month.Month, want)
+		}
+		if month.ToBeAssigned != tt.toBeAssigned || month.Assigned != tt.assigned {
+			t.Errorf("%s: a atribuir %v e atribuído %v, esperava %v e %v", MonthKey(month.Month), month.ToBeAssigned, month.Assigned, tt.toBeAssigned, tt.assigned)
+		}
+		if len(month.Envelopes) != len(tt.envelopes) {
+			t.Fatalf("%s: %d envelopes, esperava %d", MonthKey(month.Month), len(month.Envelopes), len(tt.envelopes))
+		}
+		for id, want := range tt.envelopes {
+			balance := month.Envelope(id)
+			if balance == nil {
+				t.Fatalf("%s: envelope %d ausente", MonthKey(month.Month), id)
+			}
+			got := [4]float64{balance.Carryover, balance.Assigned, balance.Moved, balance.Available}
+			if got != want {
+				t.Errorf("%s: envelope %d = %v, esperava %v", MonthKey(month.Month), id, got, want)
+			}
+		}
+	}
+}
+
+func TestCarryoverAdjustments(t *testing.T) {
+	entries := envelopeLedger()
+	months := ComputeEnvelopeMonths(date(2026, 1, 1), date(2026, 3, 1), []uint{1, 2}, entries, envelopeActivity(120))
+
+	adjustments := CarryoverAdjustments(1, months, entries)
+	want := map[string]float64{
+		"2026-02 a atribuir": 1000 - 400,
+		"2026-02 1":          180,
+		"2026-02 2":          -50,
+		"2026-03 a atribuir": 1100,
+		"2026-03 1":          100,
+	}
+	assertAdjustments(t, adjustments, want)
+
+	// Com os ajustes gravados, o mesmo cálculo não gera nada novo
+	entries = append(entries, adjustments...)
+	if again := CarryoverAdjustments(1, months, entries); len(again) != 0 {
+		t.Fatalf("segunda execução gerou %d ajustes, esperava nenhum", len(again))
+	}
+
+	// Uma despesa de janeiro corrigida de 120 para 100 gera apenas a diferença
+	months = ComputeEnvelopeMonths(date(2026, 1, 1), date(2026, 3, 1), []uint{1, 2}, entries, envelopeActivity(100))
+	assertAdjustments(t, CarryoverAdjustments(1, months, entries), map[string]float64{
+		"2026-02 1": 20,
+		"2026-03 1": 20,
+	})
+}
+
+func assertAdjustments(t *testing.T, adjustments []*EnvelopeEntry, want map[string]float64) {
+	t.Helper()
+
+	got := make(map[string]float64, len(adjustments))
+	for _, adjustment := range adjustments {
+		if adjustment.Kind != EnvelopeCarryover || adjustment.UserID != 1 {
+			t.Errorf("ajuste inesperado: %+v", adjustment)
+		}
+		key := MonthKey(adjustment.Month) + " a atribuir"
+		if adjustment.CategoryID != nil {
+			key = fmt.Sprintf("%s %d", MonthKey(adjustment.Month), *adjustment.CategoryID)
+		}
+		got[key] += adjustment.Amount
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("ajustes = %v, esperava %v", got, want)
+	}
+	for key, amount := range want {
+		if got[key] != amount {
+			t.Errorf("ajuste %s = %v, esperava %v", key, got[key], amount)
+		}
+	}
+}
+
+func TestEnvelopeHorizon(t *testing.T) {
+	now := time.Date(2026, 10, 17, 15, 30, 0, 0, time.UTC)
+	if got, want := EnvelopeHorizon(now), date(2027, 10, 1); !got.Equal(want) {
+		t.Errorf("EnvelopeHorizon = %s, esperava %s", got, want)
+	}
+}
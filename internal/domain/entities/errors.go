@@ -15,10 +15,19 @@ var (
 	ErrUnauthorized       = errors.ErrUnauthorized
 	ErrForbidden          = errors.ErrForbidden
 
-	ErrTransactionNotFound = errors.ErrTransactionNotFound
-	ErrCategoryNotFound    = errors.ErrCategoryNotFound
-	ErrGoalNotFound        = errors.ErrGoalNotFound
-	ErrSavingGoalNotFound  = errors.ErrSavingGoalNotFound
+	ErrTransactionNotFound   = errors.ErrTransactionNotFound
+	ErrCategoryNotFound      = errors.ErrCategoryNotFound
+	ErrGoalNotFound          = errors.ErrGoalNotFound
+	ErrSavingGoalNotFound    = errors.ErrSavingGoalNotFound
+	ErrAccountNotFound       = errors.ErrAccountNotFound
+	ErrInvoiceNotFound       = errors.ErrInvoiceNotFound
+	ErrTagNotFound           = errors.ErrTagNotFound
+	ErrAttachmentNotFound    = errors.ErrAttachmentNotFound
+	ErrImportProfileNotFound = errors.ErrImportProfileNotFound
+	ErrRuleNotFound          = errors.ErrRuleNotFound
+	ErrPayeeNotFound         = errors.ErrPayeeNotFound
+	ErrBudgetNotFound        = errors.ErrBudgetNotFound
+	ErrNotificationNotFound  = errors.ErrNotificationNotFound
 
 	ErrInsufficientFunds = errors.ErrInsufficientFunds
 	ErrInvalidAmount     = errors.ErrInvalidAmount
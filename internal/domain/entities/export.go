@@ -0,0 +1,32 @@
+package entities
+
+// ExportFormat é o formato de arquivo da exportação de transações
+type ExportFormat string
+
+const (
+	EXPORT_CSV  ExportFormat = "csv"
+	EXPORT_XLSX ExportFormat = "xlsx"
+	EXPORT_OFX  ExportFormat = "ofx"
+)
+
+// IsValid verifica se o formato de exportação é suportado
+func (f ExportFormat) IsValid() bool {
+	switch f {
+	case EXPORT_CSV, EXPORT_XLSX, EXPORT_OFX:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContentType retorna o tipo MIME do arquivo exportado
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case EXPORT_XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case EXPORT_OFX:
+		return "application/x-ofx"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
@@ -1,26 +1,71 @@
 package entities
 
-import "time"
+import (
+	"math"
+	"time"
+)
+
+type GoalKind string
+
+const (
+	// GOAL_ACCUMULATE é uma meta de juntar um valor até a data alvo
+	GOAL_ACCUMULATE GoalKind = "accumulate"
+	// GOAL_SPEND_LIMIT é um teto de gastos para o período da meta
+	GOAL_SPEND_LIMIT GoalKind = "spend_limit"
+)
+
+// daysPerMonth é a duração média de um mês, usada nas projeções
+const daysPerMonth = 365.25 / 12
+
+// maxProjectionDays limita a data projetada a 100 anos; além disso a projeção não é informada
+const maxProjectionDays = 100 * 365.25
+
+// IsValid verifica se o tipo de meta é suportado
+func (k GoalKind) IsValid() bool {
+	return k == GOAL_ACCUMULATE || k == GOAL_SPEND_LIMIT
+}
 
 type Goal struct {
 	ID          uint
 	Name        string
 	Description string
 	Amount      float64
+	Kind        GoalKind
+	StartDate   time.Time
+	TargetDate  *time.Time
+	CategoryIDs []uint
+	TagIDs      []uint
+	AccountIDs  []uint
 	UserID      uint
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// GoalProgress é a situação de uma meta calculada a partir das transações vinculadas
+type GoalProgress struct {
+	Goal                    *Goal
+	Current                 float64
+	Remaining               float64
+	PercentComplete         float64
+	MonthlyAverage          float64
+	ProjectedCompletionDate *time.Time
+	RequiredMonthly         *float64
+	Completed               bool
+	Exceeded                bool
+}
+
 // NewGoal creates a new Goal entity
 func NewGoal(name, description string, amount float64, userID uint) *Goal {
+	now := time.Now()
 	return &Goal{
 		Name:        name,
 		Description: description,
 		Amount:      amount,
+		Kind:        GOAL_ACCUMULATE,
+		StartDate:   now,
 		UserID:      userID,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 }
 
@@ -32,7 +77,157 @@ func (g *Goal) Update(name, description string, amount float64) {
 	g.UpdatedAt = time.Now()
 }
 
+// UpdateTracking atualiza o tipo, o período e os vínculos usados no cálculo do progresso
+func (g *Goal) UpdateTracking(kind GoalKind, startDate time.Time, targetDate *time.Time, categoryIDs, tagIDs, accountIDs []uint) {
+	g.Kind = kind
+	g.StartDate = startDate
+	g.TargetDate = targetDate
+	g.CategoryIDs = categoryIDs
+	g.TagIDs = tagIDs
+	g.AccountIDs = accountIDs
+	g.UpdatedAt = time.Now()
+}
+
 // BelongsToUser verifica se a meta pertence ao usuário
 func (g *Goal) BelongsToUser(userID uint) bool {
 	return g.UserID == userID
 }
+
+// PeriodEnd retorna o último instante considerado no progresso em now: o fim do
+// dia alvo, se já passou, ou o fim do dia atual
+func (g *Goal) PeriodEnd(now time.Time) time.Time {
+	end := now.UTC().Truncate(24 * time.Hour)
+	if g.TargetDate != nil {
+		if target := g.TargetDate.UTC().Truncate(24 * time.Hour); target.Before(end) {
+			end = target
+		}
+	}
+	return end.Add(24*time.Hour - time.Nanosecond)
+}
+
+// Contribution retorna quanto a transação soma ao progresso e se ela está vinculada
+// à meta. Cada tipo de vínculo informado restringe as transações: a conta deve ser
+// uma das contas, ao menos uma tag deve estar entre as tags e a categoria (ou as
+// linhas de divisão) deve estar entre as categorias. Nas metas de acumular,
+// receitas, investimentos e transferências recebidas somam, despesas e
+// transferências enviadas subtraem; nos tetos de gastos contam apenas despesas.
+func (g *Goal) Contribution(t *Transaction) (float64, bool) {
+	if g.Kind == GOAL_SPEND_LIMIT && t.Type != EXPENSE {
+		return 0, false
+	}
+
+	if len(g.AccountIDs) > 0 && (t.AccountID == nil || !containsID(g.AccountIDs, *t.AccountID)) {
+		return 0, false
+	}
+
+	if len(g.TagIDs) > 0 {
+		tagged := false
+		for _, tagID := range t.TagIDs {
+			if containsID(g.TagIDs, tagID) {
+				tagged = true
+				break
+			}
+		}
+		if !tagged {
+			return 0, false
+		}
+	}
+
+	amount := t.Amount
+	if len(g.CategoryIDs) > 0 {
+		if len(t.Splits) > 0 {
+			amount = 0
+			matched := false
+			for _, split := range t.Splits {
+				if containsID(g.CategoryIDs, split.CategoryID) {
+					amount += split.Amount
+					matched = true
+				}
+			}
+			if !matched {
+				return 0, false
+			}
+		} else if t.CategoryID == nil || !containsID(g.CategoryIDs, *t.CategoryID) {
+			return 0, false
+		}
+	}
+
+	if g.Kind == GOAL_ACCUMULATE && (t.Type == EXPENSE || (t.IsTransfer() && t.TransferDirection == TRANSFER_OUT)) {
+		return -amount, true
+	}
+	return amount, true
+}
+
+// Progress calcula a situação da meta em now a partir das transações informadas,
+// ignorando as que estão fora do período da meta. O ritmo mensal considera os dias
+// desde o início; a data projetada é quando o valor seria atingido nesse ritmo (nos
+// tetos de gastos, quando o teto se esgotaria), e o valor mensal necessário
+// distribui o que falta até a data alvo (nos tetos de gastos, o quanto ainda pode
+// ser gasto por mês). Projeções além de 100 anos ficam sem data.
+func (g *Goal) Progress(transactions []*Transaction, now time.Time) *GoalProgress {
+	today := now.UTC().Truncate(24 * time.Hour)
+	start := g.StartDate.UTC().Truncate(24 * time.Hour)
+	end := g.PeriodEnd(now)
+
+	current := 0.0
+	for _, transaction := range transactions {
+		if transaction.Date.Before(start) || transaction.Date.After(end) {
+			continue
+		}
+		if amount, ok := g.Contribution(transaction); ok {
+			current += amount
+		}
+	}
+	current = roundCents(current)
+
+	progress := &GoalProgress{
+		Goal:      g,
+		Current:   current,
+		Remaining: roundCents(math.Max(g.Amount-current, 0)),
+	}
+	if g.Amount > 0 {
+		progress.PercentComplete = math.Round(current/g.Amount*10000) / 100
+	}
+
+	elapsedDays := math.Max(today.Sub(start).Hours()/24+1, 1)
+	dailyPace := current / elapsedDays
+	progress.MonthlyAverage = roundCents(dailyPace * daysPerMonth)
+
+	targetPassed := g.TargetDate != nil && g.TargetDate.UTC().Truncate(24*time.Hour).Before(today)
+
+	switch g.Kind {
+	case GOAL_SPEND_LIMIT:
+		progress.Exceeded = current > g.Amount
+		progress.Completed = targetPassed && !progress.Exceeded
+	default:
+		progress.Completed = current >= g.Amount
+	}
+
+	if progress.Remaining > 0 && dailyPace > 0 && !targetPassed {
+		if days := math.Ceil(progress.Remaining / dailyPace); days <= maxProjectionDays {
+			projected := today.AddDate(0, 0, int(days))
+			progress.ProjectedCompletionDate = &projected
+		}
+	}
+
+	if g.TargetDate != nil && !progress.Completed && !progress.Exceeded {
+		monthsLeft := 1.0
+		if !targetPassed {
+			daysLeft := g.TargetDate.UTC().Truncate(24*time.Hour).Sub(today).Hours()/24 + 1
+			monthsLeft = math.Max(daysLeft/daysPerMonth, 1)
+		}
+		required := roundCents(progress.Remaining / monthsLeft)
+		progress.RequiredMonthly = &required
+	}
+
+	return progress
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
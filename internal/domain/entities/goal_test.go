@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func goalTransaction(transactionType TransactionType, amount float64, day time.Time) *Transaction {
+	return NewTransaction("Lançamento", amount, transactionType, day, 1)
+}
+
+func TestGoalProgress(t *testing.T) {
+	now := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+	target := date(2026, 12, 31)
+
+	// 300 em 60 dias desde o início: ritmo de 5 por dia
+	transactions := []*Transaction{
+		goalTransaction(INCOME, 500, date(2025, 12, 31)),
+		goalTransaction(INCOME, 350, date(2026, 1, 10)),
+		goalTransaction(EXPENSE, 50, date(2026, 2, 1)),
+		goalTransaction(INCOME, 100, date(2026, 3, 2)),
+	}
+
+	goal := NewGoal("Viagem", "", 1200, 1)
+	goal.UpdateTracking(GOAL_ACCUMULATE, date(2026, 1, 1), &target, nil, nil, nil)
+
+	progress := goal.Progress(transactions, now)
+	if progress.Current != 300 || progress.Remaining != 900 || progress.PercentComplete != 25 {
+		t.Errorf("progresso inesperado: atual %v, falta %v, %v%%", progress.Current, progress.Remaining, progress.PercentComplete)
+	}
+	if progress.MonthlyAverage != 152.19 {
+		t.Errorf("MonthlyAverage = %v, esperava 152.19", progress.MonthlyAverage)
+	}
+	if progress.ProjectedCompletionDate == nil || !progress.ProjectedCompletionDate.Equal(date(2026, 8, 28)) {
+		t.Errorf("ProjectedCompletionDate = %v, esperava 2026-08-28", progress.ProjectedCompletionDate)
+	}
+	if progress.RequiredMonthly == nil || progress.Completed || progress.Exceeded {
+		t.Errorf("situação inesperada: %+v", progress)
+	}
+}
+
+func TestGoalProgressProjectionLimit(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 1 por ano para juntar 10000: a projeção passaria do ano 12000
+	goal := NewGoal("Aposentadoria", "", 10000, 1)
+	goal.UpdateTracking(GOAL_ACCUMULATE, date(2025, 1, 2), nil, nil, nil, nil)
+
+	progress := goal.Progress([]*Transaction{goalTransaction(INCOME, 1, date(2025, 6, 1))}, now)
+	if progress.ProjectedCompletionDate != nil {
+		t.Errorf("ProjectedCompletionDate = %s, esperava nenhuma", progress.ProjectedCompletionDate)
+	}
+	if _, err := json.Marshal(progress); err != nil {
+		t.Errorf("json.Marshal retornou erro: %v", err)
+	}
+
+	// Dentro do limite a data continua sendo projetada
+	goal.Amount = 51
+	progress = goal.Progress([]*Transaction{goalTransaction(INCOME, 1, date(2025, 6, 1))}, now)
+	if progress.ProjectedCompletionDate == nil || progress.ProjectedCompletionDate.Year() != 2075 {
+		t.Errorf("ProjectedCompletionDate = %v, esperava uma data em 2075", progress.ProjectedCompletionDate)
+	}
+}
+
+func TestGoalProgressSpendLimit(t *testing.T) {
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	target := date(2026, 1, 31)
+
+	tests := []struct {
+		name          string
+		spent         float64
+		wantCompleted bool
+		wantExceeded  bool
+	}{
+		{name: "dentro do teto", spent: 400, wantCompleted: true},
+		{name: "teto estourado", spent: 650, wantExceeded: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := NewGoal("Mercado", "", 500, 1)
+			goal.UpdateTracking(GOAL_SPEND_LIMIT, date(2026, 1, 1), &target, nil, nil, nil)
+
+			transactions := []*Transaction{
+				goalTransaction(EXPENSE, tt.spent, date(2026, 1, 15)),
+				goalTransaction(INCOME, 1000, date(2026, 1, 5)),
+			}
+			progress := goal.Progress(transactions, now)
+			if progress.Current != tt.spent {
+				t.Errorf("Current = %v, esperava %v", progress.Current, tt.spent)
+			}
+			if progress.Completed != tt.wantCompleted || progress.Exceeded != tt.wantExceeded {
+				t.Errorf("Completed %v e Exceeded %v, esperava %v e %v", progress.Completed, progress.Exceeded, tt.wantCompleted, tt.wantExceeded)
+			}
+			if progress.ProjectedCompletionDate != nil || progress.RequiredMonthly != nil {
+				t.Errorf("meta encerrada não deveria ter projeções: %+v", progress)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package entities
+
+// ImportItem é uma linha do arquivo importado já convertida em transação
+type ImportItem struct {
+	// Line é a linha de origem no arquivo (apenas para CSV)
+	Line        int
+	Transaction *Transaction
+	// Duplicate indica que a linha já foi importada anteriormente e será ignorada
+	Duplicate bool
+	// DuplicateCandidates lista transações já gravadas que parecem ser o mesmo
+	// lançamento (ex.: digitado à mão); a linha é importada mesmo assim
+	DuplicateCandidates []uint
+	// Error descreve por que a linha não pôde ser importada; as demais seguem normalmente
+	Error string
+	// Warning sinaliza algo ignorado na linha, como uma categoria inexistente
+	Warning string
+}
+
+// AddWarning acrescenta um aviso aos já existentes na linha
+func (i *ImportItem) AddWarning(warning string) {
+	if i.Warning != "" {
+		i.Warning += "; "
+	}
+	i.Warning += warning
+}
+
+// IsValid indica se a linha foi convertida em transação sem erros
+func (i *ImportItem) IsValid() bool {
+	return i.Error == ""
+}
+
+// ImportResult resume uma importação. Quando Committed é falso trata-se apenas
+// de uma pré-visualização e nenhuma transação foi gravada.
+type ImportResult struct {
+	Committed  bool
+	Items      []*ImportItem
+	Imported   int
+	Duplicates int
+	Invalid    int
+}
@@ -0,0 +1,177 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// Formatos de data aceitos nos perfis de importação
+	dateFormatPattern = regexp.MustCompile(`^(DD|MM|YYYY|YY)([/.\-])(DD|MM|YYYY|YY)([/.\-])(DD|MM|YYYY|YY)$`)
+	// amountPattern é o valor já sem sinal e separador de milhar; evita que
+	// ParseFloat aceite NaN, Inf ou números hexadecimais
+	amountPattern = regexp.MustCompile(`^(\d+(\.\d*)?|\.\d+)$`)
+)
+
+// ImportProfile guarda o mapeamento de colunas do CSV de um banco. Os índices
+// das colunas começam em 0; colunas opcionais ficam nil quando não mapeadas.
+type ImportProfile struct {
+	ID                uint
+	UserID            uint
+	Name              string
+	AccountID         *uint
+	Delimiter         string
+	Encoding          string
+	DateFormat        string
+	DecimalSeparator  string
+	HasHeader         bool
+	SkipRows          int
+	DateColumn        int
+	DescriptionColumn int
+	AmountColumn      *int
+	DebitColumn       *int
+	CreditColumn      *int
+	CategoryColumn    *int
+	InvertAmount      bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewImportProfile creates a new ImportProfile entity with the defaults used by Brazilian banks
+func NewImportProfile(name string, userID uint) *ImportProfile {
+	return &ImportProfile{
+		Name:             strings.TrimSpace(name),
+		UserID:           userID,
+		Delimiter:        ";",
+		Encoding:         "auto",
+		DateFormat:       "DD/MM/YYYY",
+		DecimalSeparator: ",",
+		HasHeader:        true,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+}
+
+// Update copia o mapeamento de outro perfil, mantendo identificação e dono
+func (p *ImportProfile) Update(updates *ImportProfile) {
+	id, userID, createdAt := p.ID, p.UserID, p.CreatedAt
+	*p = *updates
+	p.ID, p.UserID, p.CreatedAt = id, userID, createdAt
+	p.Name = strings.TrimSpace(p.Name)
+	p.UpdatedAt = time.Now()
+}
+
+// BelongsToUser verifica se o perfil pertence ao usuário
+func (p *ImportProfile) BelongsToUser(userID uint) bool {
+	return p.UserID == userID
+}
+
+// DelimiterRune retorna o separador de colunas; "\t" representa tabulação
+func (p *ImportProfile) DelimiterRune() rune {
+	if p.Delimiter == `\t` || p.Delimiter == "\t" {
+		return '\t'
+	}
+	return []rune(p.Delimiter)[0]
+}
+
+// DateLayout converte o formato do perfil (ex.: DD/MM/YYYY) para o layout do pacote time
+func (p *ImportProfile) DateLayout() (string, error) {
+	match := dateFormatPattern.FindStringSubmatch(strings.ToUpper(p.DateFormat))
+	if match == nil {
+		return "", fmt.Errorf("formato de data inválido: %q", p.DateFormat)
+	}
+
+	seen := map[string]bool{}
+	layout := ""
+	for _, part := range match[1:] {
+		switch part {
+		case "DD":
+			layout += "02"
+		case "MM":
+			layout += "01"
+		case "YYYY":
+			layout += "2006"
+			part = "YY"
+		case "YY":
+			layout += "06"
+		default:
+			layout += part
+			continue
+		}
+		if seen[part] {
+			return "", fmt.Errorf("formato de data inválido: %q", p.DateFormat)
+		}
+		seen[part] = true
+	}
+
+	return layout, nil
+}
+
+// ParseDate interpreta a data conforme o formato do perfil
+func (p *ImportProfile) ParseDate(value string) (time.Time, error) {
+	layout, err := p.DateLayout()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Ignora a hora quando presente (ex.: "05/01/2026 10:32")
+	value = strings.TrimSpace(value)
+	if idx := strings.IndexByte(value, ' '); idx > 0 {
+		value = value[:idx]
+	}
+
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("data %q não está no formato %s", value, p.DateFormat)
+	}
+
+	return date, nil
+}
+
+// ParseAmount interpreta valores como "1.234,56", "-R$ 10,00", "(10,00)" ou "10,00-"
+func (p *ImportProfile) ParseAmount(value string) (float64, error) {
+	raw := value
+	value = strings.TrimSpace(value)
+	value = strings.NewReplacer("R$", "", " ", "", " ", "").Replace(value)
+	if value == "" {
+		return 0, errors.New("valor vazio")
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative, value = true, value[1:len(value)-1]
+	}
+	if strings.HasSuffix(value, "-") {
+		negative, value = true, strings.TrimSuffix(value, "-")
+	}
+	if strings.HasPrefix(value, "-") {
+		negative, value = true, strings.TrimPrefix(value, "-")
+	}
+	value = strings.TrimPrefix(value, "+")
+
+	if p.DecimalSeparator == "," {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.ReplaceAll(value, ",", ".")
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+
+	if !amountPattern.MatchString(value) {
+		return 0, fmt.Errorf("valor inválido: %q", strings.TrimSpace(raw))
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("valor inválido: %q", strings.TrimSpace(raw))
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,91 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImportProfileParseAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		separator string
+		value     string
+		want      float64
+		wantErr   bool
+	}{
+		{name: "vírgula decimal com milhar", separator: ",", value: "1.234,56", want: 1234.56},
+		{name: "símbolo de real negativo", separator: ",", value: "-R$ 10,00", want: -10},
+		{name: "parênteses", separator: ",", value: "(10,00)", want: -10},
+		{name: "sinal no final", separator: ",", value: "10,00-", want: -10},
+		{name: "sinal positivo", separator: ",", value: "+5,5", want: 5.5},
+		{name: "ponto decimal com milhar", separator: ".", value: "1,234.56", want: 1234.56},
+		{name: "inteiro", separator: ".", value: "42", want: 42},
+		{name: "vazio", separator: ",", value: "  ", wantErr: true},
+		{name: "texto", separator: ",", value: "abc", wantErr: true},
+		{name: "NaN", separator: ".", value: "NaN", wantErr: true},
+		{name: "Inf", separator: ".", value: "Inf", wantErr: true},
+		{name: "Infinity negativo", separator: ".", value: "-Infinity", wantErr: true},
+		{name: "hexadecimal", separator: ".", value: "0x1p4", wantErr: true},
+		{name: "expoente", separator: ".", value: "1e3", wantErr: true},
+		{name: "fora do intervalo", separator: ".", value: strings.Repeat("9", 400), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &ImportProfile{DecimalSeparator: tt.separator}
+			got, err := profile.ParseAmount(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAmount(%q) = %v, esperava erro", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) retornou erro: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAmount(%q) = %v, esperava %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportProfileParseDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "dia mês ano", format: "DD/MM/YYYY", value: "05/01/2026", want: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "ignora hora", format: "DD/MM/YYYY", value: "05/01/2026 10:32", want: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "ano com dois dígitos", format: "DD.MM.YY", value: "31.12.25", want: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "ISO", format: "YYYY-MM-DD", value: "2026-02-28", want: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{name: "formato em minúsculas", format: "mm/dd/yyyy", value: "01/05/2026", want: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "fora do formato", format: "DD/MM/YYYY", value: "2026-01-05", wantErr: true},
+		{name: "data inexistente", format: "DD/MM/YYYY", value: "30/02/2026", wantErr: true},
+		{name: "formato repetido", format: "DD/DD/YYYY", value: "05/05/2026", wantErr: true},
+		{name: "formato inválido", format: "DIA/MES", value: "05/01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &ImportProfile{DateFormat: tt.format}
+			got, err := profile.ParseDate(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDate(%q) = %v, esperava erro", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) retornou erro: %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, esperava %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
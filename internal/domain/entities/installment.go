@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+)
+
+// MaxInstallments limita a quantidade de parcelas de uma compra
+const MaxInstallments = 72
+
+var installmentLabelPattern = regexp.MustCompile(`\s*\(\d+/\d+\)$`)
+
+// SplitInstallments divide o valor de uma compra em parcelas mensais.
+// Com juros (taxa mensal em %), o valor da parcela segue a tabela Price.
+// Os centavos que sobram do arredondamento vão para a primeira parcela,
+// ou para a última quando remainderOnLast for verdadeiro.
+func SplitInstallments(amount float64, count int, interestRate float64, remainderOnLast bool) []float64 {
+	if count <= 0 {
+		return nil
+	}
+
+	total := amount
+	if interestRate > 0 {
+		rate := interestRate / 100
+		payment := amount * rate / (1 - math.Pow(1+rate, -float64(count)))
+		total = math.Round(payment*100) / 100 * float64(count)
+	}
+
+	totalCents := int64(math.Round(total * 100))
+	baseCents := totalCents / int64(count)
+	remainderCents := totalCents - baseCents*int64(count)
+
+	amounts := make([]float64, count)
+	for i := range amounts {
+		amounts[i] = float64(baseCents) / 100
+	}
+
+	remainderIndex := 0
+	if remainderOnLast {
+		remainderIndex = count - 1
+	}
+	amounts[remainderIndex] = float64(baseCents+remainderCents) / 100
+
+	return amounts
+}
+
+// IsInstallment verifica se a transação é parcela de uma compra parcelada
+func (t *Transaction) IsInstallment() bool {
+	return t.InstallmentTotal > 1
+}
+
+// InstallmentGroupID retorna o ID da primeira parcela, que identifica a compra
+func (t *Transaction) InstallmentGroupID() uint {
+	if t.ParentID != nil {
+		return *t.ParentID
+	}
+	return t.ID
+}
+
+// BaseDescription retorna a descrição sem o rótulo da parcela ("1/10")
+func (t *Transaction) BaseDescription() string {
+	return installmentLabelPattern.ReplaceAllString(t.Description, "")
+}
+
+// NewInstallment cria a parcela de número number de uma compra, vencendo
+// number-1 meses após a data da compra
+func (t *Transaction) NewInstallment(number, total int, amount float64) *Transaction {
+	date := addMonthsClamped(t.Date, number-1)
+	description := fmt.Sprintf("%s (%d/%d)", t.BaseDescription(), number, total)
+
+	installment := NewTransaction(description, amount, t.Type, date, t.UserID)
+	installment.Notes = t.Notes
+	installment.CategoryID = t.CategoryID
+	installment.PayeeID = t.PayeeID
+	installment.PiggyBankID = t.PiggyBankID
+	installment.AccountID = t.AccountID
+	if len(t.TagIDs) > 0 {
+		installment.SetTags(t.TagIDs)
+	}
+	installment.InstallmentNumber = number
+	installment.InstallmentTotal = total
+
+	return installment
+}
+
+// SetInstallmentDescription atualiza a descrição mantendo o rótulo da parcela
+func (t *Transaction) SetInstallmentDescription(description string) {
+	t.Description = fmt.Sprintf("%s (%d/%d)", installmentLabelPattern.ReplaceAllString(description, ""), t.InstallmentNumber, t.InstallmentTotal)
+}
@@ -0,0 +1,91 @@
+package entities
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSplitInstallments(t *testing.T) {
+	tests := []struct {
+		name            string
+		amount          float64
+		count           int
+		interestRate    float64
+		remainderOnLast bool
+		want            []float64
+	}{
+		{name: "divisão exata", amount: 300, count: 3, want: []float64{100, 100, 100}},
+		{name: "centavos na primeira", amount: 100, count: 3, want: []float64{33.34, 33.33, 33.33}},
+		{name: "centavos na última", amount: 100, count: 3, remainderOnLast: true, want: []float64{33.33, 33.33, 33.34}},
+		{name: "vários centavos", amount: 10, count: 7, want: []float64{1.48, 1.42, 1.42, 1.42, 1.42, 1.42, 1.42}},
+		{name: "valor menor que as parcelas", amount: 0.05, count: 3, remainderOnLast: true, want: []float64{0.01, 0.01, 0.03}},
+		{name: "tabela Price 2% em 10x", amount: 1000, count: 10, interestRate: 2, want: repeat(111.33, 10)},
+		{name: "tabela Price 1% em 3x", amount: 1000, count: 3, interestRate: 1, want: []float64{340.02, 340.02, 340.02}},
+		{name: "tabela Price 1,99% em 12x", amount: 2500, count: 12, interestRate: 1.99, want: repeat(236.25, 12)},
+		{name: "quantidade inválida", amount: 100, count: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitInstallments(tt.amount, tt.count, tt.interestRate, tt.remainderOnLast)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SplitInstallments = %v, esperava %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("parcela %d = %v, esperava %v", i+1, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitInstallmentsKeepsTotal(t *testing.T) {
+	for _, amount := range []float64{0.01, 9.99, 100, 1234.56, 99999.99} {
+		for count := 2; count <= MaxInstallments; count++ {
+			cents := int64(0)
+			for _, installment := range SplitInstallments(amount, count, 0, false) {
+				cents += int64(math.Round(installment * 100))
+			}
+			if want := int64(math.Round(amount * 100)); cents != want {
+				t.Fatalf("%v em %d parcelas soma %d centavos, esperava %d", amount, count, cents, want)
+			}
+		}
+	}
+}
+
+func TestNewInstallment(t *testing.T) {
+	purchase := NewTransaction("Notebook (1/3)", 3000, EXPENSE, date(2026, 1, 31), 1)
+
+	tests := []struct {
+		number          int
+		wantDate        time.Time
+		wantDescription string
+	}{
+		{number: 1, wantDate: date(2026, 1, 31), wantDescription: "Notebook (1/3)"},
+		{number: 2, wantDate: date(2026, 2, 28), wantDescription: "Notebook (2/3)"},
+		{number: 3, wantDate: date(2026, 3, 31), wantDescription: "Notebook (3/3)"},
+	}
+
+	for _, tt := range tests {
+		installment := purchase.NewInstallment(tt.number, 3, 1000)
+		if !installment.Date.Equal(tt.wantDate) {
+			t.Errorf("parcela %d vence em %s, esperava %s", tt.number, installment.Date.Format("2006-01-02"), tt.wantDate.Format("2006-01-02"))
+		}
+		if installment.Description != tt.wantDescription {
+			t.Errorf("parcela %d com descrição %q, esperava %q", tt.number, installment.Description, tt.wantDescription)
+		}
+		if installment.InstallmentNumber != tt.number || installment.InstallmentTotal != 3 {
+			t.Errorf("parcela %d numerada como %d/%d", tt.number, installment.InstallmentNumber, installment.InstallmentTotal)
+		}
+	}
+}
+
+func repeat(value float64, count int) []float64 {
+	values := make([]float64, count)
+	for i := range values {
+		values[i] = value
+	}
+	return values
+}
@@ -0,0 +1,67 @@
+package entities
+
+import "time"
+
+type InvoiceStatus string
+
+const (
+	INVOICE_OPEN    InvoiceStatus = "open"
+	INVOICE_CLOSED  InvoiceStatus = "closed"
+	INVOICE_OVERDUE InvoiceStatus = "overdue"
+	INVOICE_PAID    InvoiceStatus = "paid"
+)
+
+// Invoice representa a fatura de um cartão de crédito. Year/Month indicam o mês de vencimento.
+type Invoice struct {
+	ID                   uint
+	AccountID            uint
+	UserID               uint
+	Year                 int
+	Month                int
+	ClosingDate          time.Time
+	DueDate              time.Time
+	PaidAt               *time.Time
+	PaymentTransactionID *uint
+	Total                float64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// NewInvoice creates a new Invoice entity
+func NewInvoice(accountID, userID uint, closingDate, dueDate time.Time) *Invoice {
+	return &Invoice{
+		AccountID:   accountID,
+		UserID:      userID,
+		Year:        dueDate.Year(),
+		Month:       int(dueDate.Month()),
+		ClosingDate: closingDate,
+		DueDate:     dueDate,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Status retorna a situação da fatura na data informada
+func (i *Invoice) Status(now time.Time) InvoiceStatus {
+	if i.PaidAt != nil {
+		return INVOICE_PAID
+	}
+	// Vencida a partir do dia seguinte ao vencimento
+	if now.After(i.DueDate.AddDate(0, 0, 1)) {
+		return INVOICE_OVERDUE
+	}
+	if now.After(i.ClosingDate) {
+		return INVOICE_CLOSED
+	}
+	return INVOICE_OPEN
+}
+
+// IsPaid verifica se a fatura já foi paga
+func (i *Invoice) IsPaid() bool {
+	return i.PaidAt != nil
+}
+
+// BelongsToUser verifica se a fatura pertence ao usuário
+func (i *Invoice) BelongsToUser(userID uint) bool {
+	return i.UserID == userID
+}
@@ -0,0 +1,80 @@
+package entities
+
+import "time"
+
+type JobRunStatus string
+type JobTrigger string
+
+const (
+	JobQueued  JobRunStatus = "queued"
+	JobRunning JobRunStatus = "running"
+	JobSuccess JobRunStatus = "success"
+	JobFailed  JobRunStatus = "failed"
+)
+
+const (
+	JobTriggerScheduled JobTrigger = "scheduled"
+	JobTriggerManual    JobTrigger = "manual"
+)
+
+// JobRun representa uma execução de uma tarefa agendada
+type JobRun struct {
+	ID         uint
+	JobName    string
+	Trigger    JobTrigger
+	Status     JobRunStatus
+	Error      string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// ScheduledJob descreve uma tarefa registrada no agendador
+type ScheduledJob struct {
+	Name    string
+	Spec    string
+	NextRun time.Time
+	LastRun *JobRun
+}
+
+// NewJobRun creates a new JobRun entity
+func NewJobRun(jobName string, trigger JobTrigger) *JobRun {
+	return &JobRun{
+		JobName:   jobName,
+		Trigger:   trigger,
+		Status:    JobRunning,
+		StartedAt: time.Now(),
+	}
+}
+
+// NewQueuedJobRun cria uma execução aguardando início, usada nos disparos manuais
+func NewQueuedJobRun(jobName string, trigger JobTrigger) *JobRun {
+	run := NewJobRun(jobName, trigger)
+	run.Status = JobQueued
+	return run
+}
+
+// Start marca o início efetivo de uma execução que estava na fila
+func (r *JobRun) Start() {
+	r.Status = JobRunning
+	r.StartedAt = time.Now()
+}
+
+// Finish registra o término da execução
+func (r *JobRun) Finish(err error) {
+	now := time.Now()
+	r.FinishedAt = &now
+	if err != nil {
+		r.Status = JobFailed
+		r.Error = err.Error()
+		return
+	}
+	r.Status = JobSuccess
+}
+
+// Duration retorna a duração da execução
+func (r *JobRun) Duration() time.Duration {
+	if r.FinishedAt == nil {
+		return time.Since(r.StartedAt)
+	}
+	return r.FinishedAt.Sub(r.StartedAt)
+}
@@ -0,0 +1,168 @@
+package entities
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// NotificationType identifica o alerta que gerou a notificação
+type NotificationType string
+
+const (
+	NotificationBudgetThreshold NotificationType = "budget_threshold"
+	NotificationLargeExpense    NotificationType = "large_expense"
+	NotificationOverdueExpense  NotificationType = "overdue_expense"
+)
+
+// OverdueLookback limita os alertas de despesas vencidas às mais recentes, para
+// não inundar o usuário com o histórico antigo não pago
+const OverdueLookback = 30 * 24 * time.Hour
+
+// Notification é um aviso exibido dentro do aplicativo. DedupKey identifica o
+// evento para que o mesmo alerta não seja gerado duas vezes.
+type Notification struct {
+	ID            uint
+	UserID        uint
+	Type          NotificationType
+	Title         string
+	Message       string
+	TransactionID *uint
+	CategoryID    *uint
+	DedupKey      string
+	ReadAt        *time.Time
+	CreatedAt     time.Time
+}
+
+// NotificationSettings são os limites que disparam os alertas do usuário
+type NotificationSettings struct {
+	UserID             uint
+	BudgetThresholds   []int
+	LargeExpenseAmount float64
+	OverdueAlerts      bool
+	UpdatedAt          time.Time
+}
+
+// NewNotification creates a new Notification entity
+func NewNotification(userID uint, notificationType NotificationType, title, message, dedupKey string) *Notification {
+	return &Notification{
+		UserID:    userID,
+		Type:      notificationType,
+		Title:     title,
+		Message:   message,
+		DedupKey:  dedupKey,
+		CreatedAt: time.Now(),
+	}
+}
+
+// MarkRead marca a notificação como lida, mantendo a data da primeira leitura
+func (n *Notification) MarkRead() {
+	if n.ReadAt == nil {
+		now := time.Now()
+		n.ReadAt = &now
+	}
+}
+
+// IsRead verifica se a notificação já foi lida
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// BelongsToUser verifica se a notificação pertence ao usuário
+func (n *Notification) BelongsToUser(userID uint) bool {
+	return n.UserID == userID
+}
+
+// DefaultNotificationSettings são os alertas de quem ainda não os configurou:
+// orçamento em 80% e 100% e despesas vencidas; despesa alta fica desativada
+func DefaultNotificationSettings(userID uint) *NotificationSettings {
+	return &NotificationSettings{
+		UserID:           userID,
+		BudgetThresholds: []int{80, 100},
+		OverdueAlerts:    true,
+	}
+}
+
+// Update atualiza os limites dos alertas
+func (s *NotificationSettings) Update(budgetThresholds []int, largeExpenseAmount float64, overdueAlerts bool) {
+	s.SetBudgetThresholds(budgetThresholds)
+	s.LargeExpenseAmount = largeExpenseAmount
+	s.OverdueAlerts = overdueAlerts
+	s.UpdatedAt = time.Now()
+}
+
+// SetBudgetThresholds guarda os percentuais em ordem crescente, sem repetições
+func (s *NotificationSettings) SetBudgetThresholds(thresholds []int) {
+	seen := make(map[int]bool, len(thresholds))
+	s.BudgetThresholds = make([]int, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		if threshold > 0 && !seen[threshold] {
+			seen[threshold] = true
+			s.BudgetThresholds = append(s.BudgetThresholds, threshold)
+		}
+	}
+	sort.Ints(s.BudgetThresholds)
+}
+
+// CrossedThreshold retorna o maior percentual atingido pelo uso do orçamento, ou 0
+func (s *NotificationSettings) CrossedThreshold(percentUsed float64) int {
+	crossed := 0
+	for _, threshold := range s.BudgetThresholds {
+		if percentUsed >= float64(threshold) {
+			crossed = threshold
+		}
+	}
+	return crossed
+}
+
+// IsLargeExpense verifica se a transação é uma despesa acima do limite configurado
+func (s *NotificationSettings) IsLargeExpense(t *Transaction) bool {
+	return s.LargeExpenseAmount > 0 && t.Type == EXPENSE && t.Amount >= s.LargeExpenseAmount
+}
+
+// IsOverdue verifica se a transação é uma despesa não paga com data anterior a
+// today, dentro de OverdueLookback
+func (s *NotificationSettings) IsOverdue(t *Transaction, today time.Time) bool {
+	return s.OverdueAlerts && t.Type == EXPENSE && !t.Paid &&
+		t.Date.Before(today) && !t.Date.Before(today.Add(-OverdueLookback))
+}
+
+// NewBudgetNotification avisa que o orçamento da categoria atingiu o percentual
+func NewBudgetNotification(status *BudgetStatus, threshold int) *Notification {
+	title := fmt.Sprintf("Orçamento de %s em %d%%", status.CategoryName, threshold)
+	message := fmt.Sprintf("Você gastou R$ %.2f de R$ %.2f disponíveis para %s em %s.",
+		status.Actual, status.Available, status.CategoryName, status.Month.Format("01/2006"))
+	if threshold >= 100 {
+		title = fmt.Sprintf("Orçamento de %s estourado", status.CategoryName)
+	}
+
+	n := NewNotification(status.Budget.UserID, NotificationBudgetThreshold, title, message,
+		fmt.Sprintf("budget:%d:%s:%d", status.Budget.ID, MonthKey(status.Month), threshold))
+	categoryID := status.Budget.CategoryID
+	n.CategoryID = &categoryID
+	return n
+}
+
+// NewLargeExpenseNotification avisa sobre uma despesa acima do limite
+func NewLargeExpenseNotification(t *Transaction, limit float64) *Notification {
+	n := NewNotification(t.UserID, NotificationLargeExpense, "Despesa alta registrada",
+		fmt.Sprintf("%s: R$ %.2f, acima do limite de R$ %.2f.", t.Description, t.Amount, limit),
+		fmt.Sprintf("large_expense:%d", t.ID))
+	n.setTransaction(t)
+	return n
+}
+
+// NewOverdueNotification avisa sobre uma despesa vencida e não paga
+func NewOverdueNotification(t *Transaction) *Notification {
+	n := NewNotification(t.UserID, NotificationOverdueExpense, "Despesa vencida",
+		fmt.Sprintf("%s (R$ %.2f) venceu em %s e ainda não foi paga.", t.Description, t.Amount, t.Date.Format("02/01/2006")),
+		fmt.Sprintf("overdue_expense:%d", t.ID))
+	n.setTransaction(t)
+	return n
+}
+
+func (n *Notification) setTransaction(t *Transaction) {
+	transactionID := t.ID
+	n.TransactionID = &transactionID
+	n.CategoryID = t.CategoryID
+}
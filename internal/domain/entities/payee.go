@@ -0,0 +1,130 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// Payee representa um favorecido ou estabelecimento. Os apelidos identificam as
+// diferentes formas como ele aparece nas descrições, como "PAG*IFOOD 1234" e "IFOOD *SP".
+type Payee struct {
+	ID                uint
+	UserID            uint
+	Name              string
+	Aliases           []string
+	DefaultCategoryID *uint
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewPayee creates a new Payee entity
+func NewPayee(name string, aliases []string, defaultCategoryID *uint, userID uint) *Payee {
+	payee := &Payee{
+		Name:              strings.TrimSpace(name),
+		DefaultCategoryID: defaultCategoryID,
+		UserID:            userID,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+	payee.SetAliases(aliases)
+	return payee
+}
+
+// Update atualiza os dados do favorecido
+func (p *Payee) Update(name string, aliases []string, defaultCategoryID *uint) {
+	p.Name = strings.TrimSpace(name)
+	p.DefaultCategoryID = defaultCategoryID
+	p.SetAliases(aliases)
+	p.UpdatedAt = time.Now()
+}
+
+// SetAliases normaliza os apelidos como as descrições são normalizadas, descartando
+// vazios e repetidos
+func (p *Payee) SetAliases(aliases []string) {
+	seen := make(map[string]bool, len(aliases))
+	p.Aliases = make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = NormalizeDescription(alias)
+		if alias == "" || seen[alias] {
+			continue
+		}
+		seen[alias] = true
+		p.Aliases = append(p.Aliases, alias)
+	}
+}
+
+// BelongsToUser verifica se o favorecido pertence ao usuário
+func (p *Payee) BelongsToUser(userID uint) bool {
+	return p.UserID == userID
+}
+
+// matchLength retorna o tamanho do maior apelido (ou do próprio nome) encontrado
+// como palavras inteiras na descrição já normalizada, ou 0 quando nenhum é encontrado
+func (p *Payee) matchLength(normalized string) int {
+	padded := " " + normalized + " "
+
+	best := 0
+	for _, alias := range append([]string{NormalizeDescription(p.Name)}, p.Aliases...) {
+		if alias != "" && len(alias) > best && strings.Contains(padded, " "+alias+" ") {
+			best = len(alias)
+		}
+	}
+	return best
+}
+
+// Matches verifica se a descrição corresponde ao favorecido
+func (p *Payee) Matches(description string) bool {
+	return p.matchLength(NormalizeDescription(description)) > 0
+}
+
+// MatchPayee encontra o favorecido da descrição. Quando vários correspondem, vence
+// o apelido mais longo, que é o mais específico.
+func MatchPayee(payees []*Payee, description string) *Payee {
+	normalized := NormalizeDescription(description)
+	if normalized == "" {
+		return nil
+	}
+
+	var match *Payee
+	best := 0
+	for _, payee := range payees {
+		if length := payee.matchLength(normalized); length > best {
+			match, best = payee, length
+		}
+	}
+
+	return match
+}
+
+// AssignPayee reconhece o favorecido pela descrição quando a transação ainda não tem
+// um e, se ela estiver sem categoria, aplica a categoria padrão dele. Transferências
+// são ignoradas. Retorna o favorecido da transação, se houver.
+func AssignPayee(payees []*Payee, transaction *Transaction) *Payee {
+	if transaction.IsTransfer() {
+		return nil
+	}
+
+	var payee *Payee
+	if transaction.PayeeID != nil {
+		for _, candidate := range payees {
+			if candidate.ID == *transaction.PayeeID {
+				payee = candidate
+				break
+			}
+		}
+	} else if payee = MatchPayee(payees, transaction.Description); payee != nil {
+		transaction.SetPayee(payee.ID)
+	}
+
+	if payee != nil && payee.DefaultCategoryID != nil && transaction.CategoryID == nil && !transaction.HasSplits() {
+		transaction.SetCategory(*payee.DefaultCategoryID)
+	}
+
+	return payee
+}
+
+// SetPayee define o favorecido da transação
+func (t *Transaction) SetPayee(payeeID uint) {
+	t.PayeeID = &payeeID
+	t.UpdatedAt = time.Now()
+}
@@ -0,0 +1,232 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RuleMatchType define como a descrição da transação é comparada ao padrão da regra
+type RuleMatchType string
+
+const (
+	RULE_MATCH_CONTAINS RuleMatchType = "contains"
+	RULE_MATCH_REGEX    RuleMatchType = "regex"
+)
+
+// Rule categoriza transações automaticamente. Todas as condições preenchidas
+// precisam ser atendidas; condições vazias aceitam qualquer valor.
+type Rule struct {
+	ID                 uint
+	UserID             uint
+	Name               string
+	Priority           int
+	Active             bool
+	DescriptionMatch   RuleMatchType
+	DescriptionPattern string
+	MinAmount          *float64
+	MaxAmount          *float64
+	AccountID          *uint
+	TransactionType    *TransactionType
+	SetCategoryID      *uint
+	SetPayeeID         *uint
+	SetTagIDs          []uint
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	pattern *regexp.Regexp
+}
+
+// RuleChange descreve o que a aplicação de uma regra altera em uma transação
+type RuleChange struct {
+	Transaction    *Transaction
+	OldCategoryID  *uint
+	NewCategoryID  *uint
+	OldPayeeID     *uint
+	NewPayeeID     *uint
+	AddedTagIDs    []uint
+	CategoryChange bool
+	PayeeChange    bool
+}
+
+// NewRule creates a new Rule entity
+func NewRule(name string, userID uint) *Rule {
+	return &Rule{
+		Name:      strings.TrimSpace(name),
+		UserID:    userID,
+		Active:    true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Update copia condições e ações de outra regra, mantendo identificação e dono
+func (r *Rule) Update(updates *Rule) {
+	id, userID, createdAt := r.ID, r.UserID, r.CreatedAt
+	*r = *updates
+	r.ID, r.UserID, r.CreatedAt = id, userID, createdAt
+	r.Name = strings.TrimSpace(r.Name)
+	r.pattern = nil
+	r.UpdatedAt = time.Now()
+}
+
+// BelongsToUser verifica se a regra pertence ao usuário
+func (r *Rule) BelongsToUser(userID uint) bool {
+	return r.UserID == userID
+}
+
+// HasConditions verifica se a regra tem ao menos uma condição
+func (r *Rule) HasConditions() bool {
+	return r.DescriptionPattern != "" || r.MinAmount != nil || r.MaxAmount != nil || r.AccountID != nil || r.TransactionType != nil
+}
+
+// HasActions verifica se a regra altera algo nas transações
+func (r *Rule) HasActions() bool {
+	return r.SetCategoryID != nil || r.SetPayeeID != nil || len(r.SetTagIDs) > 0
+}
+
+// Compile valida e prepara o padrão da descrição. Em "contains" a comparação
+// ignora maiúsculas e acentos; em "regex" ignora apenas maiúsculas.
+func (r *Rule) Compile() error {
+	if r.DescriptionPattern == "" {
+		r.pattern = nil
+		return nil
+	}
+
+	var expr string
+	switch r.DescriptionMatch {
+	case RULE_MATCH_CONTAINS, "":
+		expr = regexp.QuoteMeta(NormalizeDescription(r.DescriptionPattern))
+	case RULE_MATCH_REGEX:
+		expr = r.DescriptionPattern
+	default:
+		return fmt.Errorf("tipo de comparação inválido: %q", r.DescriptionMatch)
+	}
+
+	pattern, err := regexp.Compile("(?i)" + expr)
+	if err != nil {
+		return fmt.Errorf("expressão regular inválida: %v", err)
+	}
+
+	r.pattern = pattern
+	return nil
+}
+
+// Matches verifica se a transação atende a todas as condições da regra.
+// Transferências nunca são categorizadas por regras.
+func (r *Rule) Matches(transaction *Transaction) bool {
+	if !r.Active || transaction.IsTransfer() {
+		return false
+	}
+
+	if r.TransactionType != nil && transaction.Type != *r.TransactionType {
+		return false
+	}
+	if r.AccountID != nil && (transaction.AccountID == nil || *transaction.AccountID != *r.AccountID) {
+		return false
+	}
+	if r.MinAmount != nil && transaction.Amount < *r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != nil && transaction.Amount > *r.MaxAmount {
+		return false
+	}
+
+	if r.DescriptionPattern != "" {
+		if r.pattern == nil && r.Compile() != nil {
+			return false
+		}
+
+		description := transaction.Description
+		if r.DescriptionMatch != RULE_MATCH_REGEX {
+			description = NormalizeDescription(description)
+		}
+		if !r.pattern.MatchString(description) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ApplyRules aplica as regras por ordem de prioridade (menor valor primeiro).
+// Categoria e favorecido vêm da primeira regra que os definir e só substituem
+// valores já existentes com overwrite; tags de todas as regras atendidas são
+// acrescentadas. Retorna nil quando nada muda.
+func ApplyRules(rules []*Rule, transaction *Transaction, overwrite bool) *RuleChange {
+	sorted := make([]*Rule, len(rules))
+	copy(sorted, rules)
+	SortRules(sorted)
+
+	change := &RuleChange{
+		Transaction:   transaction,
+		OldCategoryID: transaction.CategoryID,
+		OldPayeeID:    transaction.PayeeID,
+	}
+
+	categoryDecided := transaction.HasSplits() || (transaction.CategoryID != nil && !overwrite)
+	payeeDecided := transaction.PayeeID != nil && !overwrite
+	existingTags := make(map[uint]bool, len(transaction.TagIDs))
+	for _, tagID := range transaction.TagIDs {
+		existingTags[tagID] = true
+	}
+
+	for _, rule := range sorted {
+		if !rule.Matches(transaction) {
+			continue
+		}
+
+		if rule.SetCategoryID != nil && !categoryDecided {
+			categoryDecided = true
+			if transaction.CategoryID == nil || *transaction.CategoryID != *rule.SetCategoryID {
+				categoryID := *rule.SetCategoryID
+				change.NewCategoryID = &categoryID
+				change.CategoryChange = true
+			}
+		}
+
+		if rule.SetPayeeID != nil && !payeeDecided {
+			payeeDecided = true
+			if transaction.PayeeID == nil || *transaction.PayeeID != *rule.SetPayeeID {
+				payeeID := *rule.SetPayeeID
+				change.NewPayeeID = &payeeID
+				change.PayeeChange = true
+			}
+		}
+
+		for _, tagID := range rule.SetTagIDs {
+			if !existingTags[tagID] {
+				existingTags[tagID] = true
+				change.AddedTagIDs = append(change.AddedTagIDs, tagID)
+			}
+		}
+	}
+
+	if !change.CategoryChange && !change.PayeeChange && len(change.AddedTagIDs) == 0 {
+		return nil
+	}
+
+	if change.CategoryChange {
+		transaction.SetCategory(*change.NewCategoryID)
+	}
+	if change.PayeeChange {
+		transaction.SetPayee(*change.NewPayeeID)
+	}
+	if len(change.AddedTagIDs) > 0 {
+		transaction.SetTags(append(append([]uint{}, transaction.TagIDs...), change.AddedTagIDs...))
+	}
+
+	return change
+}
+
+// SortRules ordena as regras por prioridade e, no empate, pela mais antiga
+func SortRules(rules []*Rule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority != rules[j].Priority {
+			return rules[i].Priority < rules[j].Priority
+		}
+		return rules[i].ID < rules[j].ID
+	})
+}
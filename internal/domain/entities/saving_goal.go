@@ -75,3 +75,111 @@ func (sg *SavingGoal) Update(name string, targetAmount float64, currentAmount fl
 func (sg *SavingGoal) BelongsToUser(userID uint) bool {
 	return sg.UserID == userID
 }
+
+type SavingGoalMovementKind string
+
+const (
+	SAVING_DEPOSIT    SavingGoalMovementKind = "deposit"
+	SAVING_WITHDRAWAL SavingGoalMovementKind = "withdrawal"
+	// SAVING_ADJUSTMENT registra o saldo inicial e as correções do valor atual
+	SAVING_ADJUSTMENT SavingGoalMovementKind = "adjustment"
+)
+
+// SavingGoalMovement é uma movimentação do cofrinho. Amount tem sinal (retiradas
+// são negativas) e o valor atual da meta é a soma das movimentações. Balance é o
+// saldo após a movimentação, calculado na ordem de data.
+type SavingGoalMovement struct {
+	ID           uint
+	SavingGoalID uint
+	UserID       uint
+	Kind         SavingGoalMovementKind
+	Amount       float64
+	Date         time.Time
+	Note         string
+	Balance      float64
+	CreatedAt    time.Time
+}
+
+// SavingGoalHistoryPoint resume as movimentações de um mês (AAAA-MM) e o saldo ao final dele
+type SavingGoalHistoryPoint struct {
+	Month       string
+	Deposits    float64
+	Withdrawals float64
+	Adjustments float64
+	Balance     float64
+}
+
+// NewSavingGoalMovement creates a new SavingGoalMovement entity; o valor de
+// depósitos e retiradas é informado positivo e recebe o sinal conforme o tipo
+func NewSavingGoalMovement(savingGoalID, userID uint, kind SavingGoalMovementKind, amount float64, date time.Time, note string) *SavingGoalMovement {
+	if kind == SAVING_WITHDRAWAL {
+		amount = -amount
+	}
+	return &SavingGoalMovement{
+		SavingGoalID: savingGoalID,
+		UserID:       userID,
+		Kind:         kind,
+		Amount:       amount,
+		Date:         date,
+		Note:         note,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// ApplySavingGoalBalances preenche o saldo após cada movimentação, que devem estar
+// ordenadas por data
+func ApplySavingGoalBalances(movements []*SavingGoalMovement) {
+	balance := 0.0
+	for _, movement := range movements {
+		balance = roundCents(balance + movement.Amount)
+		movement.Balance = balance
+	}
+}
+
+// SavingGoalMonthlyHistory agrupa as movimentações, ordenadas por data, por mês,
+// do mês da primeira até until, incluindo os meses sem movimentação
+func SavingGoalMonthlyHistory(movements []*SavingGoalMovement, until time.Time) []SavingGoalHistoryPoint {
+	if len(movements) == 0 {
+		return []SavingGoalHistoryPoint{}
+	}
+
+	byMonth := make(map[string]*SavingGoalHistoryPoint)
+	for _, movement := range movements {
+		key := MonthKey(movement.Date.UTC())
+		point, ok := byMonth[key]
+		if !ok {
+			point = &SavingGoalHistoryPoint{Month: key}
+			byMonth[key] = point
+		}
+
+		switch movement.Kind {
+		case SAVING_DEPOSIT:
+			point.Deposits += movement.Amount
+		case SAVING_WITHDRAWAL:
+			point.Withdrawals -= movement.Amount
+		default:
+			point.Adjustments += movement.Amount
+		}
+	}
+
+	last := MonthStart(movements[len(movements)-1].Date.UTC())
+	if end := MonthStart(until.UTC()); end.After(last) {
+		last = end
+	}
+
+	var history []SavingGoalHistoryPoint
+	balance := 0.0
+	for month := MonthStart(movements[0].Date.UTC()); !month.After(last); month = month.AddDate(0, 1, 0) {
+		point := SavingGoalHistoryPoint{Month: MonthKey(month)}
+		if totals, ok := byMonth[point.Month]; ok {
+			point.Deposits = roundCents(totals.Deposits)
+			point.Withdrawals = roundCents(totals.Withdrawals)
+			point.Adjustments = roundCents(totals.Adjustments)
+		}
+		balance = roundCents(balance + point.Deposits - point.Withdrawals + point.Adjustments)
+		point.Balance = balance
+		history = append(history, point)
+	}
+
+	return history
+}
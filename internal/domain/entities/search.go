@@ -0,0 +1,9 @@
+package entities
+
+// TransactionSearchHit é uma transação encontrada pela busca textual, com a relevância
+// calculada pelo banco e um trecho com os termos encontrados destacados
+type TransactionSearchHit struct {
+	Transaction *Transaction
+	Rank        float64
+	Snippet     string
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+type Tag struct {
+	ID        uint
+	Name      string
+	Color     string
+	UserID    uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewTag creates a new Tag entity
+func NewTag(name, color string, userID uint) *Tag {
+	return &Tag{
+		Name:      strings.TrimSpace(name),
+		Color:     color,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Update atualiza os dados da tag
+func (t *Tag) Update(name, color string) {
+	t.Name = strings.TrimSpace(name)
+	t.Color = color
+	t.UpdatedAt = time.Now()
+}
+
+// BelongsToUser verifica se a tag pertence ao usuário
+func (t *Tag) BelongsToUser(userID uint) bool {
+	return t.UserID == userID
+}
+
+// SetTags substitui as tags da transação, ignorando IDs repetidos
+func (t *Transaction) SetTags(tagIDs []uint) {
+	seen := make(map[uint]bool, len(tagIDs))
+	t.TagIDs = make([]uint, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		t.TagIDs = append(t.TagIDs, id)
+	}
+	t.UpdatedAt = time.Now()
+}
@@ -1,16 +1,24 @@
 package entities
 
 import (
+	"math"
 	"time"
 )
 
 type TransactionType string
 type RecurrenceType string
+type TransferDirection string
 
 const (
 	INCOME     TransactionType = "income"
 	EXPENSE    TransactionType = "expense"
 	INVESTMENT TransactionType = "investment"
+	TRANSFER   TransactionType = "transfer"
+)
+
+const (
+	TRANSFER_OUT TransferDirection = "out"
+	TRANSFER_IN  TransferDirection = "in"
 )
 
 const (
@@ -22,21 +30,32 @@ const (
 )
 
 type Transaction struct {
-	ID             uint
-	Description    string
-	Amount         float64
-	Type           TransactionType
-	Date           time.Time
-	CategoryID     *uint
-	PiggyBankID    *uint
-	UserID         uint
-	ParentID       *uint
-	Paid           bool
-	IsRecurrent    bool
-	RecurrenceType RecurrenceType
-	RecurrenceEnd  *time.Time
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID                uint
+	Description       string
+	Notes             string
+	Amount            float64
+	Type              TransactionType
+	Date              time.Time
+	CategoryID        *uint
+	PayeeID           *uint
+	PiggyBankID       *uint
+	AccountID         *uint
+	InvoiceID         *uint
+	UserID            uint
+	ParentID          *uint
+	Paid              bool
+	IsRecurrent       bool
+	RecurrenceType    RecurrenceType
+	RecurrenceEnd     *time.Time
+	InstallmentNumber int
+	InstallmentTotal  int
+	TransferPairID    *uint
+	TransferDirection TransferDirection
+	Splits            []TransactionSplit
+	TagIDs            []uint
+	ExternalID        string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 // NewTransaction creates a new Transaction entity
@@ -67,6 +86,12 @@ func (t *Transaction) SetPiggyBank(piggyBankID uint) {
 	t.UpdatedAt = time.Now()
 }
 
+// SetAccount define a conta da transação
+func (t *Transaction) SetAccount(accountID uint) {
+	t.AccountID = &accountID
+	t.UpdatedAt = time.Now()
+}
+
 // TogglePaid alterna o status de pagamento
 func (t *Transaction) TogglePaid() {
 	t.Paid = !t.Paid
@@ -81,6 +106,14 @@ func (t *Transaction) SetRecurrence(recurrenceType RecurrenceType, endDate *time
 	t.UpdatedAt = time.Now()
 }
 
+// ClearRecurrence remove a recorrência da transação
+func (t *Transaction) ClearRecurrence() {
+	t.IsRecurrent = false
+	t.RecurrenceType = NONE
+	t.RecurrenceEnd = nil
+	t.UpdatedAt = time.Now()
+}
+
 // Update atualiza os dados da transação
 func (t *Transaction) Update(description string, amount float64, transactionType TransactionType, date time.Time) {
 	t.Description = description
@@ -90,6 +123,35 @@ func (t *Transaction) Update(description string, amount float64, transactionType
 	t.UpdatedAt = time.Now()
 }
 
+// IsTransfer verifica se a transação é uma perna de transferência entre contas
+func (t *Transaction) IsTransfer() bool {
+	return t.Type == TRANSFER
+}
+
+// NewTransferPair cria as duas pernas de uma transferência: a saída da conta de
+// origem e a entrada na conta de destino. O vínculo entre elas (TransferPairID)
+// é preenchido pelo repositório após a criação.
+func NewTransferPair(description string, amount float64, date time.Time, userID, fromAccountID, toAccountID uint) (*Transaction, *Transaction) {
+	debit := NewTransaction(description, amount, TRANSFER, date, userID)
+	debit.SetAccount(fromAccountID)
+	debit.TransferDirection = TRANSFER_OUT
+
+	credit := NewTransaction(description, amount, TRANSFER, date, userID)
+	credit.SetAccount(toAccountID)
+	credit.TransferDirection = TRANSFER_IN
+
+	return debit, credit
+}
+
+// SignedAmount retorna o valor com sinal do ponto de vista da conta: saídas
+// (despesas, investimentos e transferências enviadas) são negativas
+func (t *Transaction) SignedAmount() float64 {
+	if t.Type == EXPENSE || t.Type == INVESTMENT || (t.IsTransfer() && t.TransferDirection == TRANSFER_OUT) {
+		return -t.Amount
+	}
+	return t.Amount
+}
+
 // IsInvestment verifica se a transação é um investimento
 func (t *Transaction) IsInvestment() bool {
 	return t.Type == INVESTMENT
@@ -99,3 +161,201 @@ func (t *Transaction) IsInvestment() bool {
 func (t *Transaction) BelongsToUser(userID uint) bool {
 	return t.UserID == userID
 }
+
+// IsValid verifica se o tipo de recorrência é suportado
+func (r RecurrenceType) IsValid() bool {
+	switch r {
+	case NONE, DAILY, WEEKLY, MONTHLY, YEARLY:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRecurrenceTemplate verifica se a transação é o modelo de uma série recorrente
+func (t *Transaction) IsRecurrenceTemplate() bool {
+	return t.IsRecurrent && t.ParentID == nil && t.RecurrenceType != NONE && t.RecurrenceType != ""
+}
+
+// OccurrenceDate retorna a data da n-ésima ocorrência da série (0 é o próprio modelo).
+// Nas recorrências mensais e anuais o dia é ajustado para o último dia do mês
+// quando o mês de destino for mais curto (ex.: 31/01 -> 28/02 -> 31/03).
+func (t *Transaction) OccurrenceDate(n int) time.Time {
+	switch t.RecurrenceType {
+	case DAILY:
+		return t.Date.AddDate(0, 0, n)
+	case WEEKLY:
+		return t.Date.AddDate(0, 0, 7*n)
+	case MONTHLY:
+		return addMonthsClamped(t.Date, n)
+	case YEARLY:
+		return addMonthsClamped(t.Date, 12*n)
+	default:
+		return t.Date
+	}
+}
+
+// PendingOccurrenceDates retorna, em ordem, até max datas de ocorrência ainda não
+// geradas: posteriores à última data já gerada (existing), não anteriores a from e
+// até limit. Datas presentes em existing nunca são repetidas.
+func (t *Transaction) PendingOccurrenceDates(existing []time.Time, from, limit time.Time, max int) []time.Time {
+	if !t.IsRecurrenceTemplate() || max <= 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(existing))
+	var latest time.Time
+	for _, date := range existing {
+		seen[date.Format("2006-01-02")] = true
+		if date.After(latest) {
+			latest = date
+		}
+	}
+
+	var dates []time.Time
+	for n := 1; len(dates) < max; n++ {
+		date := t.OccurrenceDate(n)
+		if date.After(limit) {
+			break
+		}
+		if date.Before(from) || !date.After(latest) || seen[date.Format("2006-01-02")] {
+			continue
+		}
+		dates = append(dates, date)
+	}
+
+	return dates
+}
+
+// NewOccurrence cria uma ocorrência da série vinculada ao modelo através do ParentID
+func (t *Transaction) NewOccurrence(date time.Time) *Transaction {
+	occurrence := NewTransaction(t.Description, t.Amount, t.Type, date, t.UserID)
+	occurrence.Notes = t.Notes
+	occurrence.CategoryID = t.CategoryID
+	occurrence.PayeeID = t.PayeeID
+	occurrence.PiggyBankID = t.PiggyBankID
+	occurrence.AccountID = t.AccountID
+	if t.HasSplits() {
+		occurrence.SetSplits(t.Splits)
+	}
+	if len(t.TagIDs) > 0 {
+		occurrence.SetTags(t.TagIDs)
+	}
+
+	parentID := t.ID
+	occurrence.ParentID = &parentID
+
+	return occurrence
+}
+
+// Clone retorna uma cópia da transação com divisões e tags próprias
+func (t *Transaction) Clone() *Transaction {
+	clone := *t
+	clone.Splits = append([]TransactionSplit(nil), t.Splits...)
+	clone.TagIDs = append([]uint(nil), t.TagIDs...)
+	return &clone
+}
+
+// MatchesOccurrenceOf verifica se a ocorrência continua igual à que o modelo geraria
+// na mesma data, ou seja, se não foi editada pelo usuário
+func (t *Transaction) MatchesOccurrenceOf(template *Transaction) bool {
+	expected := template.NewOccurrence(t.Date)
+
+	if t.Description != expected.Description || t.Notes != expected.Notes || t.Type != expected.Type ||
+		math.Round(t.Amount*100) != math.Round(expected.Amount*100) {
+		return false
+	}
+	if !sameID(t.CategoryID, expected.CategoryID) || !sameID(t.PayeeID, expected.PayeeID) ||
+		!sameID(t.PiggyBankID, expected.PiggyBankID) || !sameID(t.AccountID, expected.AccountID) {
+		return false
+	}
+
+	if len(t.Splits) != len(expected.Splits) {
+		return false
+	}
+	for i, split := range t.Splits {
+		other := expected.Splits[i]
+		if split.CategoryID != other.CategoryID || split.Memo != other.Memo ||
+			math.Round(split.Amount*100) != math.Round(other.Amount*100) {
+			return false
+		}
+	}
+
+	if len(t.TagIDs) != len(expected.TagIDs) {
+		return false
+	}
+	for _, id := range t.TagIDs {
+		if !containsID(expected.TagIDs, id) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// OccurrenceChanges compara as ocorrências não pagas de uma série (existing) com as
+// planejadas pelo modelo atualizado (planned). Ocorrências que ainda são cópias do
+// modelo anterior (previous) são atualizadas no lugar quando a data continua na série
+// e excluídas quando não continua; ocorrências editadas pelo usuário ou com anexos
+// (attached) são mantidas como estão. As datas planejadas sem ocorrência são
+// retornadas em creates.
+func OccurrenceChanges(previous *Transaction, existing, planned []*Transaction, attached map[uint]bool) (updates []*Transaction, deleteIDs []uint, creates []*Transaction) {
+	plannedByDate := make(map[string]*Transaction, len(planned))
+	for _, occurrence := range planned {
+		plannedByDate[occurrence.Date.Format("2006-01-02")] = occurrence
+	}
+
+	covered := make(map[string]bool, len(existing))
+	for _, occurrence := range existing {
+		key := occurrence.Date.Format("2006-01-02")
+		covered[key] = true
+
+		if !occurrence.MatchesOccurrenceOf(previous) {
+			continue
+		}
+
+		next, scheduled := plannedByDate[key]
+		switch {
+		case scheduled:
+			updated := next.Clone()
+			updated.ID = occurrence.ID
+			updated.ParentID = occurrence.ParentID
+			updated.CreatedAt = occurrence.CreatedAt
+			updated.SetSplits(next.Splits)
+			updates = append(updates, updated)
+		case !attached[occurrence.ID]:
+			deleteIDs = append(deleteIDs, occurrence.ID)
+		}
+	}
+
+	for _, occurrence := range planned {
+		if !covered[occurrence.Date.Format("2006-01-02")] {
+			creates = append(creates, occurrence)
+		}
+	}
+
+	return updates, deleteIDs, creates
+}
+
+// sameID compara dois IDs opcionais
+func sameID(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// addMonthsClamped soma meses a uma data mantendo o dia original,
+// limitado ao último dia do mês de destino
+func addMonthsClamped(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month()+time.Month(months), 1,
+		date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	day := date.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+// TransactionSplit é uma linha de divisão da transação entre categorias.
+// A soma das linhas deve ser igual ao valor da transação.
+type TransactionSplit struct {
+	ID            uint
+	TransactionID uint
+	CategoryID    uint
+	Amount        float64
+	Memo          string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewTransactionSplit creates a new TransactionSplit entity
+func NewTransactionSplit(categoryID uint, amount float64, memo string) TransactionSplit {
+	return TransactionSplit{
+		CategoryID: categoryID,
+		Amount:     amount,
+		Memo:       memo,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+// HasSplits verifica se a transação está dividida entre categorias
+func (t *Transaction) HasSplits() bool {
+	return len(t.Splits) > 0
+}
+
+// SetSplits substitui as linhas de divisão da transação
+func (t *Transaction) SetSplits(splits []TransactionSplit) {
+	t.Splits = make([]TransactionSplit, len(splits))
+	for i, split := range splits {
+		split.ID = 0
+		split.TransactionID = t.ID
+		t.Splits[i] = split
+	}
+	t.UpdatedAt = time.Now()
+}
+
+// SplitsTotal retorna a soma das linhas de divisão
+func (t *Transaction) SplitsTotal() float64 {
+	var totalCents int64
+	for _, split := range t.Splits {
+		totalCents += int64(math.Round(split.Amount * 100))
+	}
+	return float64(totalCents) / 100
+}
+
+// SplitsMatchAmount verifica, em centavos, se a soma das divisões fecha com o valor da transação
+func (t *Transaction) SplitsMatchAmount() bool {
+	return int64(math.Round(t.SplitsTotal()*100)) == int64(math.Round(t.Amount*100))
+}
@@ -0,0 +1,261 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func recurringTemplate(start time.Time, recurrenceType RecurrenceType) *Transaction {
+	transaction := NewTransaction("Aluguel", 1500, EXPENSE, start, 1)
+	transaction.ID = 10
+	transaction.SetRecurrence(recurrenceType, nil)
+	return transaction
+}
+
+func TestOccurrenceDate(t *testing.T) {
+	tests := []struct {
+		name           string
+		start          time.Time
+		recurrenceType RecurrenceType
+		want           []time.Time
+	}{
+		{
+			name:           "mensal no dia 31 ajusta ao fim do mês",
+			start:          date(2026, 1, 31),
+			recurrenceType: MONTHLY,
+			want:           []time.Time{date(2026, 1, 31), date(2026, 2, 28), date(2026, 3, 31), date(2026, 4, 30), date(2026, 5, 31)},
+		},
+		{
+			name:           "mensal no dia 30 em ano bissexto",
+			start:          date(2027, 12, 30),
+			recurrenceType: MONTHLY,
+			want:           []time.Time{date(2027, 12, 30), date(2028, 1, 30), date(2028, 2, 29), date(2028, 3, 30)},
+		},
+		{
+			name:           "anual em 29 de fevereiro",
+			start:          date(2024, 2, 29),
+			recurrenceType: YEARLY,
+			want:           []time.Time{date(2024, 2, 29), date(2025, 2, 28), date(2026, 2, 28), date(2027, 2, 28), date(2028, 2, 29)},
+		},
+		{
+			name:           "semanal",
+			start:          date(2026, 1, 28),
+			recurrenceType: WEEKLY,
+			want:           []time.Time{date(2026, 1, 28), date(2026, 2, 4), date(2026, 2, 11)},
+		},
+		{
+			name:           "diária na virada do ano",
+			start:          date(2025, 12, 31),
+			recurrenceType: DAILY,
+			want:           []time.Time{date(2025, 12, 31), date(2026, 1, 1), date(2026, 1, 2)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := recurringTemplate(tt.start, tt.recurrenceType)
+			for n, want := range tt.want {
+				if got := template.OccurrenceDate(n); !got.Equal(want) {
+					t.Errorf("OccurrenceDate(%d) = %s, esperava %s", n, got.Format("2006-01-02"), want.Format("2006-01-02"))
+				}
+			}
+		})
+	}
+}
+
+func TestPendingOccurrenceDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *Transaction
+		existing []time.Time
+		from     time.Time
+		limit    time.Time
+		max      int
+		want     []time.Time
+	}{
+		{
+			name:     "primeira geração até o limite",
+			template: recurringTemplate(date(2026, 1, 31), MONTHLY),
+			limit:    date(2026, 4, 30),
+			max:      60,
+			want:     []time.Time{date(2026, 2, 28), date(2026, 3, 31), date(2026, 4, 30)},
+		},
+		{
+			name:     "continua depois da última gerada",
+			template: recurringTemplate(date(2026, 1, 31), MONTHLY),
+			existing: []time.Time{date(2026, 2, 28), date(2026, 3, 31)},
+			limit:    date(2026, 5, 31),
+			max:      60,
+			want:     []time.Time{date(2026, 4, 30), date(2026, 5, 31)},
+		},
+		{
+			name:     "ocorrência excluída não volta",
+			template: recurringTemplate(date(2026, 1, 10), MONTHLY),
+			existing: []time.Time{date(2026, 2, 10), date(2026, 3, 10)},
+			limit:    date(2026, 3, 31),
+			max:      60,
+		},
+		{
+			name:     "respeita o máximo por execução",
+			template: recurringTemplate(date(2026, 1, 1), DAILY),
+			limit:    date(2026, 12, 31),
+			max:      3,
+			want:     []time.Time{date(2026, 1, 2), date(2026, 1, 3), date(2026, 1, 4)},
+		},
+		{
+			name:     "a partir de uma data",
+			template: recurringTemplate(date(2026, 1, 5), WEEKLY),
+			from:     date(2026, 2, 1),
+			limit:    date(2026, 2, 20),
+			max:      60,
+			want:     []time.Time{date(2026, 2, 2), date(2026, 2, 9), date(2026, 2, 16)},
+		},
+		{
+			name: "sem recorrência",
+			template: func() *Transaction {
+				transaction := recurringTemplate(date(2026, 1, 5), MONTHLY)
+				transaction.ClearRecurrence()
+				return transaction
+			}(),
+			limit: date(2026, 12, 31),
+			max:   60,
+		},
+		{
+			name: "ocorrência não é modelo",
+			template: func() *Transaction {
+				transaction := recurringTemplate(date(2026, 1, 5), MONTHLY)
+				parentID := uint(1)
+				transaction.ParentID = &parentID
+				return transaction
+			}(),
+			limit: date(2026, 12, 31),
+			max:   60,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.template.PendingOccurrenceDates(tt.existing, tt.from, tt.limit, tt.max)
+			if len(got) != len(tt.want) {
+				t.Fatalf("PendingOccurrenceDates = %v, esperava %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("data %d = %s, esperava %s", i, got[i].Format("2006-01-02"), tt.want[i].Format("2006-01-02"))
+				}
+			}
+		})
+	}
+}
+
+func TestPendingOccurrenceDatesIsIdempotent(t *testing.T) {
+	template := recurringTemplate(date(2026, 1, 31), MONTHLY)
+	limit := date(2026, 12, 31)
+
+	// Gera em lotes de 4 até esgotar, acumulando as datas como já geradas
+	var existing []time.Time
+	for run := 0; run < 10; run++ {
+		dates := template.PendingOccurrenceDates(existing, time.Time{}, limit, 4)
+		if len(dates) == 0 {
+			break
+		}
+		existing = append(existing, dates...)
+	}
+
+	if len(existing) != 11 {
+		t.Fatalf("esperava 11 ocorrências, obteve %d: %v", len(existing), existing)
+	}
+
+	seen := map[time.Time]bool{}
+	for _, occurrence := range existing {
+		if seen[occurrence] {
+			t.Errorf("ocorrência repetida em %s", occurrence.Format("2006-01-02"))
+		}
+		seen[occurrence] = true
+	}
+
+	if again := template.PendingOccurrenceDates(existing, time.Time{}, limit, 60); len(again) != 0 {
+		t.Errorf("nova execução gerou %v, esperava nenhuma", again)
+	}
+}
+
+func TestOccurrenceChanges(t *testing.T) {
+	previous := recurringTemplate(date(2026, 1, 10), MONTHLY)
+
+	// Modelo alterado: novo valor e série encerrada em março
+	template := previous.Clone()
+	template.Amount = 1600
+	end := date(2026, 3, 31)
+	template.SetRecurrence(MONTHLY, &end)
+
+	unchanged := func(id uint, day time.Time) *Transaction {
+		occurrence := previous.NewOccurrence(day)
+		occurrence.ID = id
+		return occurrence
+	}
+	edited := unchanged(3, date(2026, 3, 10))
+	edited.Amount = 1450
+
+	existing := []*Transaction{
+		unchanged(2, date(2026, 2, 10)),
+		edited,
+		unchanged(4, date(2026, 4, 10)),
+		unchanged(5, date(2026, 5, 10)),
+	}
+	planned := []*Transaction{
+		template.NewOccurrence(date(2026, 2, 10)),
+		template.NewOccurrence(date(2026, 3, 10)),
+	}
+
+	updates, deleteIDs, creates := OccurrenceChanges(previous, existing, planned, map[uint]bool{5: true})
+
+	if len(updates) != 1 || updates[0].ID != 2 || updates[0].Amount != 1600 || !updates[0].Date.Equal(date(2026, 2, 10)) {
+		t.Errorf("updates = %+v, esperava a ocorrência 2 com o novo valor", updates)
+	}
+	if len(deleteIDs) != 1 || deleteIDs[0] != 4 {
+		t.Errorf("deleteIDs = %v, esperava [4]", deleteIDs)
+	}
+	if len(creates) != 0 {
+		t.Errorf("creates = %+v, esperava nenhuma", creates)
+	}
+
+	// Datas novas da série são criadas
+	planned = append(planned, template.NewOccurrence(date(2026, 6, 10)))
+	if _, _, creates = OccurrenceChanges(previous, existing, planned, nil); len(creates) != 1 || !creates[0].Date.Equal(date(2026, 6, 10)) {
+		t.Errorf("creates = %+v, esperava a ocorrência de 10/06", creates)
+	}
+}
+
+func TestMatchesOccurrenceOf(t *testing.T) {
+	template := recurringTemplate(date(2026, 1, 10), MONTHLY)
+	template.SetSplits([]TransactionSplit{NewTransactionSplit(1, 1000, ""), NewTransactionSplit(2, 500, "")})
+	template.SetTags([]uint{1, 2})
+
+	tests := []struct {
+		name   string
+		change func(*Transaction)
+		want   bool
+	}{
+		{name: "cópia do modelo", change: func(*Transaction) {}, want: true},
+		{name: "tags em outra ordem", change: func(o *Transaction) { o.SetTags([]uint{2, 1}) }, want: true},
+		{name: "valor alterado", change: func(o *Transaction) { o.Amount = 1400 }, want: false},
+		{name: "descrição alterada", change: func(o *Transaction) { o.Description = "Aluguel + condomínio" }, want: false},
+		{name: "categoria definida", change: func(o *Transaction) { o.SetCategory(3) }, want: false},
+		{name: "divisão alterada", change: func(o *Transaction) { o.Splits[1].Memo = "ajuste" }, want: false},
+		{name: "tag removida", change: func(o *Transaction) { o.SetTags([]uint{1}) }, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrence := template.NewOccurrence(date(2026, 2, 10))
+			tt.change(occurrence)
+			if got := occurrence.MatchesOccurrenceOf(template); got != tt.want {
+				t.Errorf("MatchesOccurrenceOf = %v, esperava %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// AccountBalance representa o saldo corrente de uma conta
+type AccountBalance struct {
+	AccountID        uint    `json:"account_id"`
+	AccountName      string  `json:"account_name"`
+	Kind             string  `json:"kind"`
+	Currency         string  `json:"currency"`
+	OpeningBalance   float64 `json:"opening_balance"`
+	Income           float64 `json:"income"`
+	Expense          float64 `json:"expense"`
+	TransfersIn      float64 `json:"transfers_in"`
+	TransfersOut     float64 `json:"transfers_out"`
+	Balance          float64 `json:"balance"`
+	ProjectedBalance float64 `json:"projected_balance"`
+}
+
+type AccountRepository interface {
+	Create(ctx context.Context, account *entities.Account) error
+	GetByID(ctx context.Context, id uint) (*entities.Account, error)
+	GetByUserID(ctx context.Context, userID uint, includeArchived bool) ([]*entities.Account, error)
+	Update(ctx context.Context, account *entities.Account) error
+	Delete(ctx context.Context, id uint) error
+	ExistsByName(ctx context.Context, userID uint, name string) (bool, error)
+	HasTransactions(ctx context.Context, id uint) (bool, error)
+	// GetBalances calcula o saldo das contas não arquivadas até a data informada.
+	// Balance considera apenas transações pagas; ProjectedBalance considera todas.
+	GetBalances(ctx context.Context, userID uint, until *time.Time) ([]AccountBalance, error)
+}
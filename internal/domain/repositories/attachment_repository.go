@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *entities.Attachment) error
+	// CreateWithinQuota grava o anexo somente se o total do usuário, somado ao novo
+	// anexo, não passar de quota. A verificação e a gravação são feitas com o usuário
+	// bloqueado, para que envios simultâneos não ultrapassem a cota. Retorna o total
+	// usado antes do anexo e ErrAttachmentQuota quando a cota seria excedida.
+	CreateWithinQuota(ctx context.Context, attachment *entities.Attachment, quota int64) (int64, error)
+	GetByID(ctx context.Context, id uint) (*entities.Attachment, error)
+	GetByTransactionID(ctx context.Context, transactionID uint) ([]*entities.Attachment, error)
+	Delete(ctx context.Context, id uint) error
+	// GetTotalSizeByUser soma o tamanho, em bytes, de todos os anexos do usuário
+	GetTotalSizeByUser(ctx context.Context, userID uint) (int64, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type BudgetRepository interface {
+	Create(ctx context.Context, budget *entities.Budget) error
+	GetByID(ctx context.Context, id uint) (*entities.Budget, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.Budget, error)
+	Update(ctx context.Context, budget *entities.Budget) error
+	Delete(ctx context.Context, id uint) error
+	ExistsByCategory(ctx context.Context, userID, categoryID uint) (bool, error)
+}
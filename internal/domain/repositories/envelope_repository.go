@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// EnvelopeEntryFilters restringe a consulta ao razão de envelopes
+type EnvelopeEntryFilters struct {
+	Month      *time.Time
+	CategoryID *uint
+}
+
+type EnvelopeRepository interface {
+	// GetSettings retorna as configurações do modo envelopes, ou nil se o usuário não o ativou
+	GetSettings(ctx context.Context, userID uint) (*entities.EnvelopeSettings, error)
+	CreateSettings(ctx context.Context, settings *entities.EnvelopeSettings) error
+	// DeleteSettings desativa o modo envelopes e exclui logicamente o razão do usuário,
+	// que deixa de ser considerado se o modo for ativado de novo
+	DeleteSettings(ctx context.Context, userID uint) error
+	// GetEntries busca os lançamentos do usuário em ordem de gravação
+	GetEntries(ctx context.Context, userID uint, filters *EnvelopeEntryFilters) ([]*entities.EnvelopeEntry, error)
+	CreateEntries(ctx context.Context, entries []*entities.EnvelopeEntry) error
+	// RunLocked executa fn em uma transação de banco que serializa as operações do
+	// usuário no razão; o repositório recebido grava dentro dessa transação
+	RunLocked(ctx context.Context, userID uint, fn func(repo EnvelopeRepository) error) error
+}
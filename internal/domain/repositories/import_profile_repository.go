@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type ImportProfileRepository interface {
+	Create(ctx context.Context, profile *entities.ImportProfile) error
+	GetByID(ctx context.Context, id uint) (*entities.ImportProfile, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.ImportProfile, error)
+	Update(ctx context.Context, profile *entities.ImportProfile) error
+	Delete(ctx context.Context, id uint) error
+	ExistsByName(ctx context.Context, userID uint, name string) (bool, error)
+}
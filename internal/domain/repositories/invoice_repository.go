@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type InvoiceRepository interface {
+	// GetOrCreate busca a fatura da conta para o mês de vencimento da entidade, criando-a se não existir
+	GetOrCreate(ctx context.Context, invoice *entities.Invoice) error
+	GetByID(ctx context.Context, id uint) (*entities.Invoice, error)
+	GetByAccountID(ctx context.Context, accountID uint) ([]*entities.Invoice, error)
+	// Pay cria a transferência de pagamento (debit e credit), registra o pagamento da
+	// fatura e marca os seus lançamentos como pagos em uma única transação. O valor
+	// das pernas é o total dos lançamentos calculado dentro da transação, com a fatura
+	// bloqueada. Retorna ErrInvoiceAlreadyPaid se a fatura já tiver sido paga.
+	Pay(ctx context.Context, invoiceID uint, debit, credit *entities.Transaction, paidAt time.Time) error
+}
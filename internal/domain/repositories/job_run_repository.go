@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type JobRunRepository interface {
+	Create(ctx context.Context, run *entities.JobRun) error
+	Update(ctx context.Context, run *entities.JobRun) error
+	// GetRecentByJob busca as últimas execuções de uma tarefa, da mais recente para a mais antiga
+	GetRecentByJob(ctx context.Context, jobName string, limit int) ([]*entities.JobRun, error)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type NotificationRepository interface {
+	// CreateIfAbsent grava a notificação se o usuário ainda não tiver outra com a mesma
+	// DedupKey; retorna se ela foi criada
+	CreateIfAbsent(ctx context.Context, notification *entities.Notification) (bool, error)
+	GetByID(ctx context.Context, id uint) (*entities.Notification, error)
+	// GetByUserID busca as notificações mais recentes do usuário
+	GetByUserID(ctx context.Context, userID uint, unreadOnly bool, limit int) ([]*entities.Notification, error)
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+	Update(ctx context.Context, notification *entities.Notification) error
+	// MarkAllRead marca como lidas todas as notificações do usuário e retorna quantas mudaram
+	MarkAllRead(ctx context.Context, userID uint) (int64, error)
+	// GetSettings retorna os limites de alerta do usuário, ou nil se ele não os configurou
+	GetSettings(ctx context.Context, userID uint) (*entities.NotificationSettings, error)
+	SaveSettings(ctx context.Context, settings *entities.NotificationSettings) error
+}
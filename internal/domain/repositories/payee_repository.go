@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type PayeeRepository interface {
+	Create(ctx context.Context, payee *entities.Payee) error
+	GetByID(ctx context.Context, id uint) (*entities.Payee, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.Payee, error)
+	Update(ctx context.Context, payee *entities.Payee) error
+	Delete(ctx context.Context, id uint) error
+	ExistsByName(ctx context.Context, userID uint, name string) (bool, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type RuleRepository interface {
+	Create(ctx context.Context, rule *entities.Rule) error
+	GetByID(ctx context.Context, id uint) (*entities.Rule, error)
+	// GetByUserID busca as regras do usuário ordenadas por prioridade
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.Rule, error)
+	// GetActiveByUserID busca apenas as regras ativas, ordenadas por prioridade
+	GetActiveByUserID(ctx context.Context, userID uint) ([]*entities.Rule, error)
+	Update(ctx context.Context, rule *entities.Rule) error
+	Delete(ctx context.Context, id uint) error
+}
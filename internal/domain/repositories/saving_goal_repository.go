@@ -6,10 +6,21 @@ import (
 )
 
 type SavingGoalRepository interface {
-	Create(ctx context.Context, savingGoal *entities.SavingGoal) error
+	// Create grava a meta e, se informada, a movimentação de saldo inicial na mesma
+	// transação de banco
+	Create(ctx context.Context, savingGoal *entities.SavingGoal, opening *entities.SavingGoalMovement) error
 	GetByID(ctx context.Context, id uint) (*entities.SavingGoal, error)
 	GetByUserID(ctx context.Context, userID uint) ([]*entities.SavingGoal, error)
-	Update(ctx context.Context, savingGoal *entities.SavingGoal) error
+	// Update grava os dados da meta e, se informada a movimentação de ajuste, leva o
+	// saldo ao valor atual da entidade na mesma transação: o valor do ajuste é a
+	// diferença calculada com a meta bloqueada, e nada é registrado se não houver
+	// diferença. O valor atual só muda pelas movimentações.
+	Update(ctx context.Context, savingGoal *entities.SavingGoal, adjustment *entities.SavingGoalMovement) error
 	Delete(ctx context.Context, id uint) error
-	UpdateAmount(ctx context.Context, id uint, newAmount float64) error
+	// AddMovement grava a movimentação e recalcula o valor atual da meta como a soma
+	// do razão, com a meta bloqueada. Retorna o novo valor atual, ou
+	// ErrInsufficientFunds se ele ficasse negativo.
+	AddMovement(ctx context.Context, movement *entities.SavingGoalMovement) (float64, error)
+	// GetMovements busca as movimentações da meta ordenadas por data
+	GetMovements(ctx context.Context, savingGoalID uint) ([]*entities.SavingGoalMovement, error)
 }
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+)
+
+type TagRepository interface {
+	Create(ctx context.Context, tag *entities.Tag) error
+	GetByID(ctx context.Context, id uint) (*entities.Tag, error)
+	// GetByIDs busca as tags informadas; IDs inexistentes são ignorados
+	GetByIDs(ctx context.Context, ids []uint) ([]*entities.Tag, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.Tag, error)
+	Update(ctx context.Context, tag *entities.Tag) error
+	Delete(ctx context.Context, id uint) error
+	ExistsByName(ctx context.Context, userID uint, name string) (bool, error)
+}
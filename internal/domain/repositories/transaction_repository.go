@@ -3,18 +3,100 @@ package repositories
 import (
 	"context"
 	"my-finance-hub-api/internal/domain/entities"
+	"strconv"
 	"time"
 )
 
+// TagMatchMode define como o filtro de tags combina as tags informadas
+type TagMatchMode string
+
+const (
+	TAG_MATCH_ANY TagMatchMode = "any"
+	TAG_MATCH_ALL TagMatchMode = "all"
+)
+
+// TransactionSortField define o campo de ordenação da listagem paginada
+type TransactionSortField string
+
+const (
+	SORT_BY_DATE        TransactionSortField = "date"
+	SORT_BY_AMOUNT      TransactionSortField = "amount"
+	SORT_BY_DESCRIPTION TransactionSortField = "description"
+	SORT_BY_CREATED_AT  TransactionSortField = "created_at"
+)
+
+// IsValid verifica se o campo de ordenação é suportado
+func (f TransactionSortField) IsValid() bool {
+	switch f {
+	case SORT_BY_DATE, SORT_BY_AMOUNT, SORT_BY_DESCRIPTION, SORT_BY_CREATED_AT:
+		return true
+	}
+	return false
+}
+
+// Value retorna, como texto, o valor do campo de ordenação na transação
+func (f TransactionSortField) Value(transaction *entities.Transaction) string {
+	switch f {
+	case SORT_BY_AMOUNT:
+		return strconv.FormatFloat(transaction.Amount, 'g', -1, 64)
+	case SORT_BY_DESCRIPTION:
+		return transaction.Description
+	case SORT_BY_CREATED_AT:
+		return transaction.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return transaction.Date.Format(time.RFC3339Nano)
+	}
+}
+
+// TransactionCursor marca a última transação de uma página: o valor do campo de
+// ordenação e o ID, que desempata transações com o mesmo valor
+type TransactionCursor struct {
+	SortBy     TransactionSortField `json:"s"`
+	Descending bool                 `json:"d"`
+	Value      string               `json:"v"`
+	ID         uint                 `json:"id"`
+}
+
+// TransactionPageRequest define ordenação, tamanho e posição de uma página
+type TransactionPageRequest struct {
+	SortBy     TransactionSortField
+	Descending bool
+	Limit      int
+	After      *TransactionCursor
+}
+
+// TransactionPage é uma página da listagem com os totais de todas as transações filtradas
+type TransactionPage struct {
+	Transactions []*entities.Transaction
+	NextCursor   *TransactionCursor
+	HasMore      bool
+	Totals       *TransactionTotals
+}
+
+// TransactionTotals resume as transações filtradas; transferências entram apenas na contagem
+type TransactionTotals struct {
+	Count      int64
+	Income     float64
+	Expense    float64
+	Investment float64
+}
+
 type TransactionFilters struct {
-	UserID     uint
-	Paid       *bool
-	Month      *int
-	Year       *int
-	Type       *string
-	CategoryID *uint
-	StartDate  time.Time
-	EndDate    time.Time
+	UserID      uint
+	Paid        *bool
+	Month       *int
+	Year        *int
+	Type        *string
+	CategoryID  *uint
+	PayeeID     *uint
+	AccountID   *uint
+	TagIDs      []uint
+	TagMatch    TagMatchMode
+	StartDate   time.Time
+	EndDate     time.Time
+	MinAmount   *float64
+	MaxAmount   *float64
+	Description string
 }
 
 // MonthlyStats representa as estatísticas de transações por mês
@@ -29,10 +111,70 @@ type TransactionRepository interface {
 	Create(ctx context.Context, transaction *entities.Transaction) error
 	GetByID(ctx context.Context, id uint) (*entities.Transaction, error)
 	GetByUserID(ctx context.Context, userID uint, filters *TransactionFilters) ([]*entities.Transaction, error)
+	// ListByUserID busca uma página das transações filtradas por paginação de chave
+	// (campo de ordenação, id). Retorna também se há mais transações depois da página.
+	ListByUserID(ctx context.Context, userID uint, filters *TransactionFilters, page *TransactionPageRequest) ([]*entities.Transaction, bool, error)
+	// GetTotals calcula contagem e somas por tipo de todas as transações filtradas
+	GetTotals(ctx context.Context, userID uint, filters *TransactionFilters) (*TransactionTotals, error)
+	// StreamByUserID percorre as transações filtradas em lotes, da mais antiga para a
+	// mais recente, sem carregar todas em memória
+	StreamByUserID(ctx context.Context, userID uint, filters *TransactionFilters, batchSize int, fn func([]*entities.Transaction) error) error
 	Update(ctx context.Context, transaction *entities.Transaction) error
 	Delete(ctx context.Context, id uint) error
+	// CreateTransfer grava as duas pernas de uma transferência na mesma transação de banco,
+	// vinculando-as pelo TransferPairID
+	CreateTransfer(ctx context.Context, debit, credit *entities.Transaction) error
+	// UpdateTransfer atualiza as duas pernas de uma transferência atomicamente
+	UpdateTransfer(ctx context.Context, debit, credit *entities.Transaction) error
+	// DeleteTransfer exclui as duas pernas de uma transferência atomicamente
+	DeleteTransfer(ctx context.Context, debitID, creditID uint) error
 	GetByDateRange(ctx context.Context, userID uint, startDate, endDate time.Time) ([]*entities.Transaction, error)
+	// GetUnpaidExpenses busca as despesas não pagas de todos os usuários com data no
+	// intervalo [startDate, endDate), usadas nos alertas de vencimento
+	GetUnpaidExpenses(ctx context.Context, startDate, endDate time.Time) ([]*entities.Transaction, error)
+	// GetRecurringTransactions busca os modelos de séries recorrentes do usuário
 	GetRecurringTransactions(ctx context.Context, userID uint) ([]*entities.Transaction, error)
+	// GetAllRecurringTransactions busca os modelos de séries recorrentes de todos os usuários
+	GetAllRecurringTransactions(ctx context.Context) ([]*entities.Transaction, error)
+	// GetOccurrenceDates busca as datas das ocorrências já geradas para um modelo recorrente,
+	// incluindo as excluídas, para que não sejam recriadas
+	GetOccurrenceDates(ctx context.Context, parentID uint) ([]time.Time, error)
+	// CreateOccurrences grava em uma única transação o modelo recorrente (quando ainda
+	// não tem ID) e as ocorrências informadas, ignorando datas já geradas. O modelo fica
+	// bloqueado durante a gravação para que execuções simultâneas não dupliquem
+	// ocorrências. Retorna quantas ocorrências foram criadas.
+	CreateOccurrences(ctx context.Context, template *entities.Transaction, occurrences []*entities.Transaction) (int, error)
+	// UpdateRecurrence grava o modelo recorrente e, na mesma transação, concilia as
+	// ocorrências não pagas a partir de from com as informadas: as que ainda são
+	// cópias do modelo anterior (previous) são atualizadas no lugar ou, se a data saiu
+	// da série, excluídas logicamente; as editadas ou com anexos são mantidas
+	UpdateRecurrence(ctx context.Context, previous, template *entities.Transaction, from time.Time, occurrences []*entities.Transaction) error
+	// CreateInstallments grava as parcelas de uma compra em uma única transação; a
+	// primeira parcela identifica a compra e as demais são vinculadas a ela (ParentID)
+	CreateInstallments(ctx context.Context, installments []*entities.Transaction) error
+	// GetInstallments busca todas as parcelas de uma compra a partir do ID da primeira parcela
+	GetInstallments(ctx context.Context, groupID uint) ([]*entities.Transaction, error)
+	GetByInvoiceID(ctx context.Context, invoiceID uint) ([]*entities.Transaction, error)
+	// GetByIDs busca as transações informadas; IDs inexistentes ou excluídos são ignorados
+	GetByIDs(ctx context.Context, ids []uint) ([]*entities.Transaction, error)
+	// GetDuplicatePairs busca pares de transações do usuário com mesmo tipo e valor e
+	// datas a até window de distância, candidatos a duplicata
+	GetDuplicatePairs(ctx context.Context, userID uint, window time.Duration) ([][2]uint, error)
+	// Search busca as transações do usuário cujo texto (descrição, observações, favorecido
+	// e tags) contém todos os termos, ordenadas pela relevância
+	Search(ctx context.Context, userID uint, text string, filters *TransactionFilters, limit int) ([]*entities.TransactionSearchHit, error)
+	// GetCategorizationChanges busca as transações do usuário, exceto transferências,
+	// alteradas ou excluídas a partir de since, da mais antiga para a mais recente
+	GetCategorizationChanges(ctx context.Context, userID uint, since time.Time) ([]CategorizationChange, error)
+	// MergeDuplicates atualiza a transação mantida e exclui (soft delete) as duplicatas
+	// na mesma transação de banco, transferindo seus anexos
+	MergeDuplicates(ctx context.Context, keep *entities.Transaction, duplicateIDs []uint) error
+	// ApplyBulk grava as transações alteradas e exclui (soft delete) as informadas
+	// em uma única transação de banco; nada é gravado se alguma operação falhar
+	ApplyBulk(ctx context.Context, userID uint, updates []*entities.Transaction, deleteIDs []uint) error
+	// GetExistingExternalIDs retorna quais dos identificadores externos (ex.: FITID do OFX)
+	// já foram importados pelo usuário, incluindo transações excluídas
+	GetExistingExternalIDs(ctx context.Context, userID uint, externalIDs []string) (map[string]bool, error)
 	GetInvestmentsByPiggyBank(ctx context.Context, piggyBankID uint) ([]*entities.Transaction, error)
 	// GetTotalAmountByType busca o total de transações por tipo, com suporte a filtros de data
 	GetTotalAmountByType(ctx context.Context, userID uint, transactionType entities.TransactionType, startDate, endDate *time.Time) (float64, error)
@@ -41,11 +183,61 @@ type TransactionRepository interface {
 	GetMonthlyStats(ctx context.Context, userID uint, year int, startDate, endDate *time.Time) ([]MonthlyStats, error)
 	// GetCategoryTotals busca os totais de transações agrupados por categoria
 	GetCategoryTotals(ctx context.Context, userID uint, filters *TransactionFilters) ([]CategoryTotal, error)
+	// GetCategoryMonthlyTotals busca os totais por categoria e mês, com a mesma
+	// agregação de GetCategoryTotals
+	GetCategoryMonthlyTotals(ctx context.Context, userID uint, filters *TransactionFilters) ([]CategoryMonthTotal, error)
+	// GetMonthlyTypeTotals busca os totais por mês e tipo, exceto transferências
+	GetMonthlyTypeTotals(ctx context.Context, userID uint, startDate, endDate time.Time) ([]MonthTypeTotal, error)
+	// GetTagTotals busca os totais de transações agrupados por tag
+	GetTagTotals(ctx context.Context, userID uint, filters *TransactionFilters) ([]TagTotal, error)
+	// GetPayeeTotals busca os totais de transações agrupados por favorecido
+	GetPayeeTotals(ctx context.Context, userID uint, filters *TransactionFilters) ([]PayeeTotal, error)
 }
 
 // Estrutura para representar totais por categoria
 type CategoryTotal struct {
+	CategoryID   uint
 	CategoryName string
 	Total        float64
 	Type         string
 }
+
+// CategoryMonthTotal é o total de uma categoria em um mês (AAAA-MM)
+type CategoryMonthTotal struct {
+	CategoryID uint
+	Month      string
+	Total      float64
+}
+
+// MonthTypeTotal é o total das transações de um tipo em um mês (AAAA-MM)
+type MonthTypeTotal struct {
+	Month string
+	Type  string
+	Total float64
+}
+
+// CategorizationChange é o estado de uma transação alterada ou excluída, usado no
+// treino incremental das sugestões de categoria
+type CategorizationChange struct {
+	TransactionID uint
+	Description   string
+	CategoryID    *uint
+	ChangedAt     time.Time
+	Deleted       bool
+}
+
+// Estrutura para representar totais por tag
+type TagTotal struct {
+	TagName string
+	Total   float64
+	Type    string
+}
+
+// Estrutura para representar totais por favorecido
+type PayeeTotal struct {
+	PayeeID   uint
+	PayeeName string
+	Total     float64
+	Count     int
+	Type      string
+}
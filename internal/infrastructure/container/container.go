@@ -1,12 +1,17 @@
 package container
 
 import (
+	"log"
+
+	"my-finance-hub-api/config"
 	"my-finance-hub-api/internal/application/interfaces"
 	"my-finance-hub-api/internal/application/services"
 	"my-finance-hub-api/internal/domain/repositories"
 	dbRepos "my-finance-hub-api/internal/infrastructure/database/repositories"
 	"my-finance-hub-api/internal/infrastructure/http/controllers"
 	"my-finance-hub-api/internal/infrastructure/http/middleware"
+	"my-finance-hub-api/internal/infrastructure/scheduler"
+	"my-finance-hub-api/internal/infrastructure/storage"
 
 	"gorm.io/gorm"
 )
@@ -15,58 +20,162 @@ type Container struct {
 	// Database
 	DB *gorm.DB
 
+	// Config
+	Config *config.Config
+
+	// Storage
+	BlobStorage interfaces.BlobStorage
+
 	// Repositories
-	UserRepository        repositories.UserRepository
-	CategoryRepository    repositories.CategoryRepository
-	GoalRepository        repositories.GoalRepository
-	SavingGoalRepository  repositories.SavingGoalRepository
-	TransactionRepository repositories.TransactionRepository
+	UserRepository          repositories.UserRepository
+	CategoryRepository      repositories.CategoryRepository
+	GoalRepository          repositories.GoalRepository
+	SavingGoalRepository    repositories.SavingGoalRepository
+	TransactionRepository   repositories.TransactionRepository
+	JobRunRepository        repositories.JobRunRepository
+	AccountRepository       repositories.AccountRepository
+	InvoiceRepository       repositories.InvoiceRepository
+	TagRepository           repositories.TagRepository
+	AttachmentRepository    repositories.AttachmentRepository
+	ImportProfileRepository repositories.ImportProfileRepository
+	RuleRepository          repositories.RuleRepository
+	PayeeRepository         repositories.PayeeRepository
+	BudgetRepository        repositories.BudgetRepository
+	EnvelopeRepository      repositories.EnvelopeRepository
+	NotificationRepository  repositories.NotificationRepository
 
 	// Services
-	AuthService        interfaces.AuthService
-	CategoryService    interfaces.CategoryService
-	GoalService        interfaces.GoalService
-	SavingGoalService  interfaces.SavingGoalService
-	TransactionService interfaces.TransactionService
+	AuthService         interfaces.AuthService
+	CategoryService     interfaces.CategoryService
+	GoalService         interfaces.GoalService
+	SavingGoalService   interfaces.SavingGoalService
+	TransactionService  interfaces.TransactionService
+	SchedulerService    interfaces.SchedulerService
+	AccountService      interfaces.AccountService
+	InvoiceService      interfaces.InvoiceService
+	TagService          interfaces.TagService
+	AttachmentService   interfaces.AttachmentService
+	ImportService       interfaces.ImportService
+	ExportService       interfaces.ExportService
+	RuleService         interfaces.RuleService
+	SuggestionService   interfaces.CategorySuggestionService
+	PayeeService        interfaces.PayeeService
+	BudgetService       interfaces.BudgetService
+	EnvelopeService     interfaces.EnvelopeService
+	NotificationService interfaces.NotificationService
 
 	// Controllers
-	AuthController        *controllers.AuthController
-	CategoryController    *controllers.CategoryController
-	GoalController        *controllers.GoalController
-	SavingGoalController  *controllers.SavingGoalController
-	TransactionController *controllers.TransactionController
+	AuthController         *controllers.AuthController
+	CategoryController     *controllers.CategoryController
+	GoalController         *controllers.GoalController
+	SavingGoalController   *controllers.SavingGoalController
+	TransactionController  *controllers.TransactionController
+	JobController          *controllers.JobController
+	AccountController      *controllers.AccountController
+	InvoiceController      *controllers.InvoiceController
+	TagController          *controllers.TagController
+	ImportController       *controllers.ImportController
+	ExportController       *controllers.ExportController
+	RuleController         *controllers.RuleController
+	PayeeController        *controllers.PayeeController
+	BudgetController       *controllers.BudgetController
+	EnvelopeController     *controllers.EnvelopeController
+	NotificationController *controllers.NotificationController
 
 	// Middleware
 	AuthMiddleware *middleware.AuthMiddleware
 }
 
-func NewContainer(db *gorm.DB) *Container {
+func NewContainer(db *gorm.DB, cfg *config.Config) *Container {
 	container := &Container{
-		DB: db,
+		DB:     db,
+		Config: cfg,
 	}
 
+	container.initStorage()
 	container.initRepositories()
 	container.initServices()
+	container.initJobs()
 	container.initControllers()
 	container.initMiddleware()
 
 	return container
 }
 
+// initStorage configura o armazenamento de arquivos conforme STORAGE_DRIVER
+func (c *Container) initStorage() {
+	// Por enquanto apenas o armazenamento local está disponível
+	if driver := c.Config.Storage.Driver; driver != "local" {
+		log.Printf("Driver de armazenamento %q desconhecido, usando armazenamento local", driver)
+	}
+
+	localStorage, err := storage.NewLocalStorage(c.Config.Storage.LocalPath)
+	if err != nil {
+		log.Fatal("Falha ao inicializar o armazenamento de arquivos: ", err)
+	}
+	c.BlobStorage = localStorage
+}
+
 func (c *Container) initRepositories() {
 	c.UserRepository = dbRepos.NewUserRepository(c.DB)
 	c.CategoryRepository = dbRepos.NewCategoryRepository(c.DB)
 	c.GoalRepository = dbRepos.NewGoalRepository(c.DB)
 	c.SavingGoalRepository = dbRepos.NewSavingGoalRepository(c.DB)
 	c.TransactionRepository = dbRepos.NewTransactionRepository(c.DB)
+	c.JobRunRepository = dbRepos.NewJobRunRepository(c.DB)
+	c.AccountRepository = dbRepos.NewAccountRepository(c.DB)
+	c.InvoiceRepository = dbRepos.NewInvoiceRepository(c.DB)
+	c.TagRepository = dbRepos.NewTagRepository(c.DB)
+	c.AttachmentRepository = dbRepos.NewAttachmentRepository(c.DB)
+	c.ImportProfileRepository = dbRepos.NewImportProfileRepository(c.DB)
+	c.RuleRepository = dbRepos.NewRuleRepository(c.DB)
+	c.PayeeRepository = dbRepos.NewPayeeRepository(c.DB)
+	c.BudgetRepository = dbRepos.NewBudgetRepository(c.DB)
+	c.EnvelopeRepository = dbRepos.NewEnvelopeRepository(c.DB)
+	c.NotificationRepository = dbRepos.NewNotificationRepository(c.DB)
 }
 
 func (c *Container) initServices() {
 	c.AuthService = services.NewAuthService(c.UserRepository)
 	c.CategoryService = services.NewCategoryService(c.CategoryRepository)
-	c.GoalService = services.NewGoalService(c.GoalRepository)
+	c.GoalService = services.NewGoalService(c.GoalRepository, c.TransactionRepository, c.CategoryRepository, c.TagRepository, c.AccountRepository)
 	c.SavingGoalService = services.NewSavingGoalService(c.SavingGoalRepository)
-	c.TransactionService = services.NewTransactionService(c.TransactionRepository)
+	c.PayeeService = services.NewPayeeService(c.PayeeRepository, c.CategoryRepository)
+	c.BudgetService = services.NewBudgetService(c.BudgetRepository, c.CategoryRepository, c.TransactionRepository)
+	c.EnvelopeService = services.NewEnvelopeService(c.EnvelopeRepository, c.TransactionRepository, c.CategoryRepository)
+	c.NotificationService = services.NewNotificationService(c.NotificationRepository, c.TransactionRepository, c.BudgetService)
+	c.RuleService = services.NewRuleService(c.RuleRepository, c.TransactionRepository, c.AccountRepository, c.CategoryRepository,
+		c.TagRepository, c.PayeeRepository)
+	c.TransactionService = services.NewTransactionService(c.TransactionRepository, c.AccountRepository, c.InvoiceRepository, c.TagRepository,
+		c.CategoryRepository, c.RuleService, c.PayeeService, c.BudgetService, c.NotificationService)
+	c.AccountService = services.NewAccountService(c.AccountRepository)
+	c.InvoiceService = services.NewInvoiceService(c.InvoiceRepository, c.AccountRepository, c.TransactionRepository)
+	c.TagService = services.NewTagService(c.TagRepository)
+	c.AttachmentService = services.NewAttachmentService(c.AttachmentRepository, c.TransactionRepository, c.BlobStorage,
+		c.Config.Storage.MaxAttachmentSize, c.Config.Storage.UserQuota)
+	c.ImportService = services.NewImportService(c.TransactionRepository, c.AccountRepository, c.CategoryRepository,
+		c.ImportProfileRepository, c.TransactionService, c.RuleService, c.PayeeService)
+	c.SuggestionService = services.NewCategorySuggestionService(c.TransactionRepository, c.CategoryRepository)
+	c.ExportService = services.NewExportService(c.TransactionRepository, c.CategoryRepository, c.AccountRepository, c.TagRepository)
+	c.SchedulerService = scheduler.NewScheduler(c.DB, c.JobRunRepository)
+}
+
+// initJobs registra as tarefas periódicas executadas pelo agendador
+func (c *Container) initJobs() {
+	jobs := []struct {
+		name string
+		spec string
+		run  interfaces.JobFunc
+	}{
+		{"recurring_transactions", c.Config.Scheduler.RecurrenceSpec, c.TransactionService.GenerateAllRecurringTransactions},
+		{"overdue_notifications", c.Config.Scheduler.OverdueSpec, c.NotificationService.NotifyOverdueExpenses},
+	}
+
+	for _, job := range jobs {
+		if err := c.SchedulerService.Register(job.name, job.spec, job.run); err != nil {
+			log.Printf("Erro ao registrar tarefa agendada: %v", err)
+		}
+	}
 }
 
 func (c *Container) initControllers() {
@@ -74,9 +183,20 @@ func (c *Container) initControllers() {
 	c.CategoryController = controllers.NewCategoryController(c.CategoryService)
 	c.GoalController = controllers.NewGoalController(c.GoalService)
 	c.SavingGoalController = controllers.NewSavingGoalController(c.SavingGoalService)
-	c.TransactionController = controllers.NewTransactionController(c.TransactionService)
+	c.TransactionController = controllers.NewTransactionController(c.TransactionService, c.AttachmentService, c.SuggestionService)
+	c.JobController = controllers.NewJobController(c.SchedulerService)
+	c.AccountController = controllers.NewAccountController(c.AccountService)
+	c.InvoiceController = controllers.NewInvoiceController(c.InvoiceService)
+	c.TagController = controllers.NewTagController(c.TagService)
+	c.ImportController = controllers.NewImportController(c.ImportService)
+	c.ExportController = controllers.NewExportController(c.ExportService)
+	c.RuleController = controllers.NewRuleController(c.RuleService)
+	c.PayeeController = controllers.NewPayeeController(c.PayeeService)
+	c.BudgetController = controllers.NewBudgetController(c.BudgetService)
+	c.EnvelopeController = controllers.NewEnvelopeController(c.EnvelopeService)
+	c.NotificationController = controllers.NewNotificationController(c.NotificationService)
 }
 
 func (c *Container) initMiddleware() {
-	c.AuthMiddleware = middleware.NewAuthMiddleware(c.AuthService)
+	c.AuthMiddleware = middleware.NewAuthMiddleware(c.AuthService, c.Config.Admin.Emails)
 }
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 
+	"my-finance-hub-api/internal/domain/entities"
 	"my-finance-hub-api/internal/infrastructure/database/models"
 
 	"gorm.io/driver/postgres"
@@ -43,7 +44,28 @@ func SetupDatabase() error {
 		&models.Category{},
 		&models.Goal{},
 		&models.SavingGoal{},
+		&models.SavingGoalMovement{},
+		&models.Account{},
+		&models.Tag{},
+		&models.Payee{},
+		&models.PayeeAlias{},
+		&models.Budget{},
+		&models.EnvelopeSettings{},
+		&models.EnvelopeEntry{},
+		&models.Notification{},
+		&models.NotificationSettings{},
+		&models.Invoice{},
 		&models.Transaction{},
+		&models.TransactionSplit{},
+		&models.TransactionTag{},
+		&models.Attachment{},
+		&models.ImportProfile{},
+		&models.Rule{},
+		&models.RuleTag{},
+		&models.GoalCategory{},
+		&models.GoalTag{},
+		&models.GoalAccount{},
+		&models.JobRun{},
 	)
 
 	if err != nil {
@@ -51,6 +73,59 @@ func SetupDatabase() error {
 		return err
 	}
 
+	if err := backfillSavingGoalMovements(); err != nil {
+		log.Printf("Erro ao gerar o saldo inicial dos cofrinhos: %v", err)
+		return err
+	}
+
+	// Sem a configuração de busca o restante da API continua funcionando
+	if err := setupTextSearch(); err != nil {
+		log.Printf("Erro ao configurar a busca textual: %v", err)
+	}
+
 	log.Println("Banco de dados configurado com sucesso")
 	return nil
 }
+
+// backfillSavingGoalMovements registra como saldo inicial o valor atual dos cofrinhos
+// criados antes do razão de movimentações, para que o valor continue sendo a soma dele
+func backfillSavingGoalMovements() error {
+	return DB.Exec(`
+		INSERT INTO saving_goal_movements (saving_goal_id, user_id, kind, amount, date, note, created_at)
+		SELECT sg.id, sg.user_id, ?, sg.current_amount, sg.created_at, ?, NOW()
+		FROM saving_goals sg
+		WHERE sg.current_amount <> 0
+			AND NOT EXISTS (SELECT 1 FROM saving_goal_movements m WHERE m.saving_goal_id = sg.id)`,
+		entities.SAVING_ADJUSTMENT, "Saldo inicial",
+	).Error
+}
+
+// setupTextSearch cria a configuração "portuguese_unaccent", que remove acentos antes
+// de aplicar o dicionário português, usada na busca de transações, e a coluna gerada
+// search_vector (descrição e observações) com índice GIN. A coluna fica fora do
+// modelo, pois é mantida pelo banco.
+func setupTextSearch() error {
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS unaccent`,
+		`DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'portuguese_unaccent') THEN
+				CREATE TEXT SEARCH CONFIGURATION portuguese_unaccent (COPY = portuguese);
+				ALTER TEXT SEARCH CONFIGURATION portuguese_unaccent
+					ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+			END IF;
+		END
+		$$`,
+		`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (to_tsvector('portuguese_unaccent'::regconfig, coalesce(description, '') || ' ' || coalesce(notes, ''))) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_transactions_search_vector ON transactions USING GIN (search_vector)`,
+	}
+
+	for _, statement := range statements {
+		if err := DB.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
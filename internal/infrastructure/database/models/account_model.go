@@ -0,0 +1,63 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Account struct {
+	ID             uint   `gorm:"primaryKey"`
+	Name           string `gorm:"not null"`
+	Kind           string `gorm:"not null"`
+	Institution    string
+	OpeningBalance float64 `gorm:"default:0"`
+	Currency       string  `gorm:"size:3;default:BRL"`
+	Archived       bool    `gorm:"default:false"`
+	ClosingDay     int     `gorm:"default:0"`
+	DueDay         int     `gorm:"default:0"`
+	UserID         uint    `gorm:"not null;index"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (a *Account) FromEntity(entity *entities.Account) {
+	a.ID = entity.ID
+	a.Name = entity.Name
+	a.Kind = string(entity.Kind)
+	a.Institution = entity.Institution
+	a.OpeningBalance = entity.OpeningBalance
+	a.Currency = entity.Currency
+	a.Archived = entity.Archived
+	a.ClosingDay = entity.ClosingDay
+	a.DueDay = entity.DueDay
+	a.UserID = entity.UserID
+	a.CreatedAt = entity.CreatedAt
+	a.UpdatedAt = entity.UpdatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (a *Account) ToEntity() *entities.Account {
+	return &entities.Account{
+		ID:             a.ID,
+		Name:           a.Name,
+		Kind:           entities.AccountKind(a.Kind),
+		Institution:    a.Institution,
+		OpeningBalance: a.OpeningBalance,
+		Currency:       a.Currency,
+		Archived:       a.Archived,
+		ClosingDay:     a.ClosingDay,
+		DueDay:         a.DueDay,
+		UserID:         a.UserID,
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (Account) TableName() string {
+	return "accounts"
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type Attachment struct {
+	ID            uint   `gorm:"primaryKey"`
+	TransactionID uint   `gorm:"not null;index"`
+	UserID        uint   `gorm:"not null;index"`
+	FileName      string `gorm:"not null;size:255"`
+	ContentType   string `gorm:"not null;size:100"`
+	Size          int64  `gorm:"not null"`
+	StorageKey    string `gorm:"not null;size:500"`
+	ThumbnailKey  string `gorm:"size:500"`
+	CreatedAt     time.Time
+
+	// Relacionamento usado apenas para criar a chave estrangeira
+	Transaction *Transaction `gorm:"foreignKey:TransactionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (a *Attachment) FromEntity(entity *entities.Attachment) {
+	a.ID = entity.ID
+	a.TransactionID = entity.TransactionID
+	a.UserID = entity.UserID
+	a.FileName = entity.FileName
+	a.ContentType = entity.ContentType
+	a.Size = entity.Size
+	a.StorageKey = entity.StorageKey
+	a.ThumbnailKey = entity.ThumbnailKey
+	a.CreatedAt = entity.CreatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (a *Attachment) ToEntity() *entities.Attachment {
+	return &entities.Attachment{
+		ID:            a.ID,
+		TransactionID: a.TransactionID,
+		UserID:        a.UserID,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		StorageKey:    a.StorageKey,
+		ThumbnailKey:  a.ThumbnailKey,
+		CreatedAt:     a.CreatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (Attachment) TableName() string {
+	return "attachments"
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type Budget struct {
+	ID         uint      `gorm:"primaryKey"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_budget_user_category"`
+	CategoryID uint      `gorm:"not null;uniqueIndex:idx_budget_user_category"`
+	Amount     float64   `gorm:"not null"`
+	Rollover   bool      `gorm:"not null;default:false"`
+	StartMonth time.Time `gorm:"not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+
+	// A exclusão definitiva da categoria remove o orçamento; categorias excluídas
+	// logicamente são ignoradas no acompanhamento
+	Category *Category `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (b *Budget) FromEntity(entity *entities.Budget) {
+	b.ID = entity.ID
+	b.UserID = entity.UserID
+	b.CategoryID = entity.CategoryID
+	b.Amount = entity.Amount
+	b.Rollover = entity.Rollover
+	b.StartMonth = entity.StartMonth
+	b.CreatedAt = entity.CreatedAt
+	b.UpdatedAt = entity.UpdatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (b *Budget) ToEntity() *entities.Budget {
+	return &entities.Budget{
+		ID:         b.ID,
+		UserID:     b.UserID,
+		CategoryID: b.CategoryID,
+		Amount:     b.Amount,
+		Rollover:   b.Rollover,
+		// O mês inicial é sempre tratado em UTC, independente do fuso da conexão
+		StartMonth: entities.MonthStart(b.StartMonth.UTC()),
+		CreatedAt:  b.CreatedAt,
+		UpdatedAt:  b.UpdatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (Budget) TableName() string {
+	return "budgets"
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EnvelopeSettings struct {
+	UserID     uint      `gorm:"primaryKey;autoIncrement:false"`
+	StartMonth time.Time `gorm:"not null"`
+	CreatedAt  time.Time
+}
+
+// EnvelopeEntry é um lançamento do razão de envelopes; apenas inserido, nunca alterado.
+// Ao desativar o modo o razão é excluído logicamente e fica preservado no banco.
+type EnvelopeEntry struct {
+	ID                uint      `gorm:"primaryKey"`
+	UserID            uint      `gorm:"not null;index:idx_envelope_entry_user_month"`
+	CategoryID        *uint     `gorm:"index"`
+	RelatedCategoryID *uint     `gorm:"column:related_category_id"`
+	Month             time.Time `gorm:"not null;index:idx_envelope_entry_user_month"`
+	Kind              string    `gorm:"not null;size:20"`
+	Amount            float64   `gorm:"not null"`
+	Note              string    `gorm:"size:255"`
+	CreatedAt         time.Time
+	DeletedAt         gorm.DeletedAt `gorm:"index"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (s *EnvelopeSettings) FromEntity(entity *entities.EnvelopeSettings) {
+	s.UserID = entity.UserID
+	s.StartMonth = entity.StartMonth
+	s.CreatedAt = entity.CreatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (s *EnvelopeSettings) ToEntity() *entities.EnvelopeSettings {
+	return &entities.EnvelopeSettings{
+		UserID:     s.UserID,
+		StartMonth: entities.MonthStart(s.StartMonth.UTC()),
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (e *EnvelopeEntry) FromEntity(entity *entities.EnvelopeEntry) {
+	e.ID = entity.ID
+	e.UserID = entity.UserID
+	e.CategoryID = entity.CategoryID
+	e.RelatedCategoryID = entity.RelatedCategoryID
+	e.Month = entity.Month
+	e.Kind = string(entity.Kind)
+	e.Amount = entity.Amount
+	e.Note = entity.Note
+	e.CreatedAt = entity.CreatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (e *EnvelopeEntry) ToEntity() *entities.EnvelopeEntry {
+	return &entities.EnvelopeEntry{
+		ID:                e.ID,
+		UserID:            e.UserID,
+		CategoryID:        e.CategoryID,
+		RelatedCategoryID: e.RelatedCategoryID,
+		Month:             entities.MonthStart(e.Month.UTC()),
+		Kind:              entities.EnvelopeEntryKind(e.Kind),
+		Amount:            e.Amount,
+		Note:              e.Note,
+		CreatedAt:         e.CreatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (EnvelopeSettings) TableName() string {
+	return "envelope_settings"
+}
+
+// TableName especifica o nome da tabela
+func (EnvelopeEntry) TableName() string {
+	return "envelope_entries"
+}
@@ -12,10 +12,37 @@ type Goal struct {
 	Name        string `gorm:"not null"`
 	Description string
 	Amount      float64 `gorm:"not null"`
-	UserID      uint    `gorm:"not null"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	Kind        string  `gorm:"not null;size:20;default:accumulate"`
+	// Nulo nas metas criadas antes do acompanhamento de progresso; vale a data de criação
+	StartDate  *time.Time
+	TargetDate *time.Time
+	UserID     uint `gorm:"not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+
+	// Vínculos que definem quais transações contam para o progresso
+	CategoryLinks []GoalCategory `gorm:"foreignKey:GoalID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	TagLinks      []GoalTag      `gorm:"foreignKey:GoalID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	AccountLinks  []GoalAccount  `gorm:"foreignKey:GoalID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// GoalCategory é a tabela de ligação entre metas e categorias
+type GoalCategory struct {
+	GoalID     uint `gorm:"primaryKey"`
+	CategoryID uint `gorm:"primaryKey;index"`
+}
+
+// GoalTag é a tabela de ligação entre metas e tags
+type GoalTag struct {
+	GoalID uint `gorm:"primaryKey"`
+	TagID  uint `gorm:"primaryKey;index"`
+}
+
+// GoalAccount é a tabela de ligação entre metas e contas
+type GoalAccount struct {
+	GoalID    uint `gorm:"primaryKey"`
+	AccountID uint `gorm:"primaryKey;index"`
 }
 
 // FromEntity converte uma entidade de domínio para o modelo GORM
@@ -24,25 +51,87 @@ func (g *Goal) FromEntity(entity *entities.Goal) {
 	g.Name = entity.Name
 	g.Description = entity.Description
 	g.Amount = entity.Amount
+	g.Kind = string(entity.Kind)
+	g.TargetDate = entity.TargetDate
 	g.UserID = entity.UserID
 	g.CreatedAt = entity.CreatedAt
 	g.UpdatedAt = entity.UpdatedAt
+
+	g.StartDate = nil
+	if !entity.StartDate.IsZero() {
+		startDate := entity.StartDate
+		g.StartDate = &startDate
+	}
+
+	g.CategoryLinks = make([]GoalCategory, len(entity.CategoryIDs))
+	for i, categoryID := range entity.CategoryIDs {
+		g.CategoryLinks[i] = GoalCategory{GoalID: entity.ID, CategoryID: categoryID}
+	}
+
+	g.TagLinks = make([]GoalTag, len(entity.TagIDs))
+	for i, tagID := range entity.TagIDs {
+		g.TagLinks[i] = GoalTag{GoalID: entity.ID, TagID: tagID}
+	}
+
+	g.AccountLinks = make([]GoalAccount, len(entity.AccountIDs))
+	for i, accountID := range entity.AccountIDs {
+		g.AccountLinks[i] = GoalAccount{GoalID: entity.ID, AccountID: accountID}
+	}
 }
 
 // ToEntity converte o modelo GORM para uma entidade de domínio
 func (g *Goal) ToEntity() *entities.Goal {
-	return &entities.Goal{
+	goal := &entities.Goal{
 		ID:          g.ID,
 		Name:        g.Name,
 		Description: g.Description,
 		Amount:      g.Amount,
+		Kind:        entities.GoalKind(g.Kind),
+		StartDate:   g.CreatedAt,
+		TargetDate:  g.TargetDate,
 		UserID:      g.UserID,
 		CreatedAt:   g.CreatedAt,
 		UpdatedAt:   g.UpdatedAt,
 	}
+
+	if g.StartDate != nil {
+		goal.StartDate = *g.StartDate
+	}
+
+	goal.CategoryIDs = make([]uint, len(g.CategoryLinks))
+	for i, link := range g.CategoryLinks {
+		goal.CategoryIDs[i] = link.CategoryID
+	}
+
+	goal.TagIDs = make([]uint, len(g.TagLinks))
+	for i, link := range g.TagLinks {
+		goal.TagIDs[i] = link.TagID
+	}
+
+	goal.AccountIDs = make([]uint, len(g.AccountLinks))
+	for i, link := range g.AccountLinks {
+		goal.AccountIDs[i] = link.AccountID
+	}
+
+	return goal
 }
 
 // TableName especifica o nome da tabela
 func (Goal) TableName() string {
 	return "goals"
 }
+
+// TableName especifica o nome da tabela
+func (GoalCategory) TableName() string {
+	return "goal_categories"
+}
+
+// TableName especifica o nome da tabela
+func (GoalTag) TableName() string {
+	return "goal_tags"
+}
+
+// TableName especifica o nome da tabela
+func (GoalAccount) TableName() string {
+	return "goal_accounts"
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type ImportProfile struct {
+	ID                uint   `gorm:"primaryKey"`
+	UserID            uint   `gorm:"not null;uniqueIndex:idx_import_profile_user_name"`
+	Name              string `gorm:"not null;size:100;uniqueIndex:idx_import_profile_user_name"`
+	AccountID         *uint  `gorm:"index"`
+	Delimiter         string `gorm:"not null;size:2"`
+	Encoding          string `gorm:"not null;size:20"`
+	DateFormat        string `gorm:"not null;size:20"`
+	DecimalSeparator  string `gorm:"not null;size:1"`
+	HasHeader         bool   `gorm:"not null;default:true"`
+	SkipRows          int    `gorm:"not null;default:0"`
+	DateColumn        int    `gorm:"not null"`
+	DescriptionColumn int    `gorm:"not null"`
+	AmountColumn      *int
+	DebitColumn       *int
+	CreditColumn      *int
+	CategoryColumn    *int
+	InvertAmount      bool `gorm:"not null;default:false"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+
+	// Excluir a conta mantém o perfil, apenas sem conta padrão
+	Account *Account `gorm:"foreignKey:AccountID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (p *ImportProfile) FromEntity(entity *entities.ImportProfile) {
+	p.ID = entity.ID
+	p.UserID = entity.UserID
+	p.Name = entity.Name
+	p.AccountID = entity.AccountID
+	p.Delimiter = entity.Delimiter
+	p.Encoding = entity.Encoding
+	p.DateFormat = entity.DateFormat
+	p.DecimalSeparator = entity.DecimalSeparator
+	p.HasHeader = entity.HasHeader
+	p.SkipRows = entity.SkipRows
+	p.DateColumn = entity.DateColumn
+	p.DescriptionColumn = entity.DescriptionColumn
+	p.AmountColumn = entity.AmountColumn
+	p.DebitColumn = entity.DebitColumn
+	p.CreditColumn = entity.CreditColumn
+	p.CategoryColumn = entity.CategoryColumn
+	p.InvertAmount = entity.InvertAmount
+	p.CreatedAt = entity.CreatedAt
+	p.UpdatedAt = entity.UpdatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (p *ImportProfile) ToEntity() *entities.ImportProfile {
+	return &entities.ImportProfile{
+		ID:                p.ID,
+		UserID:            p.UserID,
+		Name:              p.Name,
+		AccountID:         p.AccountID,
+		Delimiter:         p.Delimiter,
+		Encoding:          p.Encoding,
+		DateFormat:        p.DateFormat,
+		DecimalSeparator:  p.DecimalSeparator,
+		HasHeader:         p.HasHeader,
+		SkipRows:          p.SkipRows,
+		DateColumn:        p.DateColumn,
+		DescriptionColumn: p.DescriptionColumn,
+		AmountColumn:      p.AmountColumn,
+		DebitColumn:       p.DebitColumn,
+		CreditColumn:      p.CreditColumn,
+		CategoryColumn:    p.CategoryColumn,
+		InvertAmount:      p.InvertAmount,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (ImportProfile) TableName() string {
+	return "import_profiles"
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Invoice struct {
+	ID                   uint      `gorm:"primaryKey"`
+	AccountID            uint      `gorm:"not null;uniqueIndex:idx_invoice_account_month"`
+	UserID               uint      `gorm:"not null;index"`
+	Year                 int       `gorm:"not null;uniqueIndex:idx_invoice_account_month"`
+	Month                int       `gorm:"not null;uniqueIndex:idx_invoice_account_month"`
+	ClosingDate          time.Time `gorm:"not null"`
+	DueDate              time.Time `gorm:"not null"`
+	PaidAt               *time.Time
+	PaymentTransactionID *uint
+	// Total é calculado a partir das transações da fatura (somente leitura)
+	Total     float64 `gorm:"->;-:migration"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Account *Account `gorm:"foreignKey:AccountID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (i *Invoice) FromEntity(entity *entities.Invoice) {
+	i.ID = entity.ID
+	i.AccountID = entity.AccountID
+	i.UserID = entity.UserID
+	i.Year = entity.Year
+	i.Month = entity.Month
+	i.ClosingDate = entity.ClosingDate
+	i.DueDate = entity.DueDate
+	i.PaidAt = entity.PaidAt
+	i.PaymentTransactionID = entity.PaymentTransactionID
+	i.CreatedAt = entity.CreatedAt
+	i.UpdatedAt = entity.UpdatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (i *Invoice) ToEntity() *entities.Invoice {
+	return &entities.Invoice{
+		ID:                   i.ID,
+		AccountID:            i.AccountID,
+		UserID:               i.UserID,
+		Year:                 i.Year,
+		Month:                i.Month,
+		ClosingDate:          i.ClosingDate,
+		DueDate:              i.DueDate,
+		PaidAt:               i.PaidAt,
+		PaymentTransactionID: i.PaymentTransactionID,
+		Total:                i.Total,
+		CreatedAt:            i.CreatedAt,
+		UpdatedAt:            i.UpdatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (Invoice) TableName() string {
+	return "invoices"
+}
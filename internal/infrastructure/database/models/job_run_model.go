@@ -0,0 +1,45 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type JobRun struct {
+	ID         uint      `gorm:"primaryKey"`
+	JobName    string    `gorm:"not null;index"`
+	Trigger    string    `gorm:"not null"`
+	Status     string    `gorm:"not null"`
+	Error      string    `gorm:"type:text"`
+	StartedAt  time.Time `gorm:"not null;index"`
+	FinishedAt *time.Time
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (j *JobRun) FromEntity(entity *entities.JobRun) {
+	j.ID = entity.ID
+	j.JobName = entity.JobName
+	j.Trigger = string(entity.Trigger)
+	j.Status = string(entity.Status)
+	j.Error = entity.Error
+	j.StartedAt = entity.StartedAt
+	j.FinishedAt = entity.FinishedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (j *JobRun) ToEntity() *entities.JobRun {
+	return &entities.JobRun{
+		ID:         j.ID,
+		JobName:    j.JobName,
+		Trigger:    entities.JobTrigger(j.Trigger),
+		Status:     entities.JobRunStatus(j.Status),
+		Error:      j.Error,
+		StartedAt:  j.StartedAt,
+		FinishedAt: j.FinishedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (JobRun) TableName() string {
+	return "job_runs"
+}
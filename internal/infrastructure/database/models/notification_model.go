@@ -0,0 +1,103 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Notification struct {
+	ID            uint       `gorm:"primaryKey"`
+	UserID        uint       `gorm:"not null;uniqueIndex:idx_notification_user_dedup;index:idx_notification_user_read"`
+	Type          string     `gorm:"not null;size:30"`
+	Title         string     `gorm:"not null;size:255"`
+	Message       string     `gorm:"type:text"`
+	TransactionID *uint      `gorm:"column:transaction_id"`
+	CategoryID    *uint      `gorm:"column:category_id"`
+	DedupKey      string     `gorm:"not null;size:100;uniqueIndex:idx_notification_user_dedup"`
+	ReadAt        *time.Time `gorm:"index:idx_notification_user_read"`
+	CreatedAt     time.Time
+}
+
+type NotificationSettings struct {
+	UserID             uint   `gorm:"primaryKey;autoIncrement:false"`
+	BudgetThresholds   string `gorm:"size:100"`
+	LargeExpenseAmount float64
+	OverdueAlerts      bool `gorm:"not null;default:true"`
+	UpdatedAt          time.Time
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (n *Notification) FromEntity(entity *entities.Notification) {
+	n.ID = entity.ID
+	n.UserID = entity.UserID
+	n.Type = string(entity.Type)
+	n.Title = entity.Title
+	n.Message = entity.Message
+	n.TransactionID = entity.TransactionID
+	n.CategoryID = entity.CategoryID
+	n.DedupKey = entity.DedupKey
+	n.ReadAt = entity.ReadAt
+	n.CreatedAt = entity.CreatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (n *Notification) ToEntity() *entities.Notification {
+	return &entities.Notification{
+		ID:            n.ID,
+		UserID:        n.UserID,
+		Type:          entities.NotificationType(n.Type),
+		Title:         n.Title,
+		Message:       n.Message,
+		TransactionID: n.TransactionID,
+		CategoryID:    n.CategoryID,
+		DedupKey:      n.DedupKey,
+		ReadAt:        n.ReadAt,
+		CreatedAt:     n.CreatedAt,
+	}
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM; os percentuais
+// do orçamento são gravados separados por vírgula
+func (s *NotificationSettings) FromEntity(entity *entities.NotificationSettings) {
+	thresholds := make([]string, len(entity.BudgetThresholds))
+	for i, threshold := range entity.BudgetThresholds {
+		thresholds[i] = strconv.Itoa(threshold)
+	}
+
+	s.UserID = entity.UserID
+	s.BudgetThresholds = strings.Join(thresholds, ",")
+	s.LargeExpenseAmount = entity.LargeExpenseAmount
+	s.OverdueAlerts = entity.OverdueAlerts
+	s.UpdatedAt = entity.UpdatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (s *NotificationSettings) ToEntity() *entities.NotificationSettings {
+	var thresholds []int
+	for _, value := range strings.Split(s.BudgetThresholds, ",") {
+		if threshold, err := strconv.Atoi(value); err == nil {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+
+	settings := &entities.NotificationSettings{
+		UserID:             s.UserID,
+		LargeExpenseAmount: s.LargeExpenseAmount,
+		OverdueAlerts:      s.OverdueAlerts,
+		UpdatedAt:          s.UpdatedAt,
+	}
+	settings.SetBudgetThresholds(thresholds)
+	return settings
+}
+
+// TableName especifica o nome da tabela
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// TableName especifica o nome da tabela
+func (NotificationSettings) TableName() string {
+	return "notification_settings"
+}
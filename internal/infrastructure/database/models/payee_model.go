@@ -0,0 +1,70 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type Payee struct {
+	ID                uint   `gorm:"primaryKey"`
+	Name              string `gorm:"not null;size:100;uniqueIndex:idx_payee_user_name"`
+	UserID            uint   `gorm:"not null;uniqueIndex:idx_payee_user_name"`
+	DefaultCategoryID *uint  `gorm:"column:default_category_id"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+
+	// Apelidos normalizados usados para reconhecer o favorecido nas descrições
+	AliasLinks []PayeeAlias `gorm:"foreignKey:PayeeID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Excluir a categoria padrão apenas a remove do favorecido
+	DefaultCategory *Category `gorm:"foreignKey:DefaultCategoryID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+// PayeeAlias guarda cada apelido de um favorecido
+type PayeeAlias struct {
+	PayeeID uint   `gorm:"primaryKey"`
+	Alias   string `gorm:"primaryKey;size:100"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (p *Payee) FromEntity(entity *entities.Payee) {
+	p.ID = entity.ID
+	p.Name = entity.Name
+	p.UserID = entity.UserID
+	p.DefaultCategoryID = entity.DefaultCategoryID
+	p.CreatedAt = entity.CreatedAt
+	p.UpdatedAt = entity.UpdatedAt
+
+	p.AliasLinks = make([]PayeeAlias, len(entity.Aliases))
+	for i, alias := range entity.Aliases {
+		p.AliasLinks[i] = PayeeAlias{PayeeID: entity.ID, Alias: alias}
+	}
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (p *Payee) ToEntity() *entities.Payee {
+	payee := &entities.Payee{
+		ID:                p.ID,
+		Name:              p.Name,
+		UserID:            p.UserID,
+		DefaultCategoryID: p.DefaultCategoryID,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+
+	payee.Aliases = make([]string, len(p.AliasLinks))
+	for i, link := range p.AliasLinks {
+		payee.Aliases[i] = link.Alias
+	}
+
+	return payee
+}
+
+// TableName especifica o nome da tabela
+func (Payee) TableName() string {
+	return "payees"
+}
+
+// TableName especifica o nome da tabela
+func (PayeeAlias) TableName() string {
+	return "payee_aliases"
+}
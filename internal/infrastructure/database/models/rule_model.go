@@ -0,0 +1,108 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type Rule struct {
+	ID                 uint   `gorm:"primaryKey"`
+	UserID             uint   `gorm:"not null;index"`
+	Name               string `gorm:"not null;size:100"`
+	Priority           int    `gorm:"not null;default:0"`
+	Active             bool   `gorm:"not null;default:true"`
+	DescriptionMatch   string `gorm:"size:10"`
+	DescriptionPattern string `gorm:"size:255"`
+	MinAmount          *float64
+	MaxAmount          *float64
+	AccountID          *uint   `gorm:"index"`
+	TransactionType    *string `gorm:"size:20"`
+	SetCategoryID      *uint   `gorm:"column:set_category_id"`
+	SetPayeeID         *uint   `gorm:"column:set_payee_id"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	// Tags acrescentadas às transações atendidas
+	TagLinks []RuleTag `gorm:"foreignKey:RuleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Excluir a conta remove a regra, que deixaria de ter sentido
+	Account *Account `gorm:"foreignKey:AccountID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Excluir o favorecido apenas remove essa ação da regra
+	SetPayee *Payee `gorm:"foreignKey:SetPayeeID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+// RuleTag é a tabela de ligação entre regras e as tags que elas aplicam
+type RuleTag struct {
+	RuleID uint `gorm:"primaryKey"`
+	TagID  uint `gorm:"primaryKey;index"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (r *Rule) FromEntity(entity *entities.Rule) {
+	r.ID = entity.ID
+	r.UserID = entity.UserID
+	r.Name = entity.Name
+	r.Priority = entity.Priority
+	r.Active = entity.Active
+	r.DescriptionMatch = string(entity.DescriptionMatch)
+	r.DescriptionPattern = entity.DescriptionPattern
+	r.MinAmount = entity.MinAmount
+	r.MaxAmount = entity.MaxAmount
+	r.AccountID = entity.AccountID
+	r.SetCategoryID = entity.SetCategoryID
+	r.SetPayeeID = entity.SetPayeeID
+	r.CreatedAt = entity.CreatedAt
+	r.UpdatedAt = entity.UpdatedAt
+
+	r.TransactionType = nil
+	if entity.TransactionType != nil {
+		transactionType := string(*entity.TransactionType)
+		r.TransactionType = &transactionType
+	}
+
+	r.TagLinks = make([]RuleTag, len(entity.SetTagIDs))
+	for i, tagID := range entity.SetTagIDs {
+		r.TagLinks[i] = RuleTag{RuleID: entity.ID, TagID: tagID}
+	}
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (r *Rule) ToEntity() *entities.Rule {
+	rule := &entities.Rule{
+		ID:                 r.ID,
+		UserID:             r.UserID,
+		Name:               r.Name,
+		Priority:           r.Priority,
+		Active:             r.Active,
+		DescriptionMatch:   entities.RuleMatchType(r.DescriptionMatch),
+		DescriptionPattern: r.DescriptionPattern,
+		MinAmount:          r.MinAmount,
+		MaxAmount:          r.MaxAmount,
+		AccountID:          r.AccountID,
+		SetCategoryID:      r.SetCategoryID,
+		SetPayeeID:         r.SetPayeeID,
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
+	}
+
+	if r.TransactionType != nil {
+		transactionType := entities.TransactionType(*r.TransactionType)
+		rule.TransactionType = &transactionType
+	}
+
+	rule.SetTagIDs = make([]uint, len(r.TagLinks))
+	for i, link := range r.TagLinks {
+		rule.SetTagIDs[i] = link.TagID
+	}
+
+	return rule
+}
+
+// TableName especifica o nome da tabela
+func (Rule) TableName() string {
+	return "rules"
+}
+
+// TableName especifica o nome da tabela
+func (RuleTag) TableName() string {
+	return "rule_tags"
+}
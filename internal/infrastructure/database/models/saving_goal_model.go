@@ -8,10 +8,11 @@ import (
 )
 
 type SavingGoal struct {
-	ID            uint   `gorm:"primaryKey"`
-	Name          string `gorm:"not null"`
-	Description   string
-	TargetAmount  float64 `gorm:"not null"`
+	ID           uint   `gorm:"primaryKey"`
+	Name         string `gorm:"not null"`
+	Description  string
+	TargetAmount float64 `gorm:"not null"`
+	// Soma das movimentações, mantida em cache para listagens
 	CurrentAmount float64 `gorm:"default:0"`
 	UserID        uint    `gorm:"not null"`
 	CreatedAt     time.Time
@@ -19,10 +20,25 @@ type SavingGoal struct {
 	DeletedAt     gorm.DeletedAt `gorm:"index"`
 }
 
+// SavingGoalMovement é uma movimentação do razão do cofrinho
+type SavingGoalMovement struct {
+	ID           uint      `gorm:"primaryKey"`
+	SavingGoalID uint      `gorm:"not null;index:idx_saving_goal_movement_goal_date"`
+	UserID       uint      `gorm:"not null;index"`
+	Kind         string    `gorm:"not null;size:20"`
+	Amount       float64   `gorm:"not null"`
+	Date         time.Time `gorm:"not null;index:idx_saving_goal_movement_goal_date"`
+	Note         string    `gorm:"size:255"`
+	CreatedAt    time.Time
+
+	SavingGoal *SavingGoal `gorm:"foreignKey:SavingGoalID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
 // FromEntity converte uma entidade de domínio para o modelo GORM
 func (sg *SavingGoal) FromEntity(entity *entities.SavingGoal) {
 	sg.ID = entity.ID
 	sg.Name = entity.Name
+	sg.Description = entity.Description
 	sg.TargetAmount = entity.TargetAmount
 	sg.CurrentAmount = entity.CurrentAmount
 	sg.UserID = entity.UserID
@@ -35,6 +51,7 @@ func (sg *SavingGoal) ToEntity() *entities.SavingGoal {
 	return &entities.SavingGoal{
 		ID:            sg.ID,
 		Name:          sg.Name,
+		Description:   sg.Description,
 		TargetAmount:  sg.TargetAmount,
 		CurrentAmount: sg.CurrentAmount,
 		UserID:        sg.UserID,
@@ -43,7 +60,38 @@ func (sg *SavingGoal) ToEntity() *entities.SavingGoal {
 	}
 }
 
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (m *SavingGoalMovement) FromEntity(entity *entities.SavingGoalMovement) {
+	m.ID = entity.ID
+	m.SavingGoalID = entity.SavingGoalID
+	m.UserID = entity.UserID
+	m.Kind = string(entity.Kind)
+	m.Amount = entity.Amount
+	m.Date = entity.Date
+	m.Note = entity.Note
+	m.CreatedAt = entity.CreatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (m *SavingGoalMovement) ToEntity() *entities.SavingGoalMovement {
+	return &entities.SavingGoalMovement{
+		ID:           m.ID,
+		SavingGoalID: m.SavingGoalID,
+		UserID:       m.UserID,
+		Kind:         entities.SavingGoalMovementKind(m.Kind),
+		Amount:       m.Amount,
+		Date:         m.Date,
+		Note:         m.Note,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
 // TableName especifica o nome da tabela
 func (SavingGoal) TableName() string {
 	return "saving_goals"
 }
+
+// TableName especifica o nome da tabela
+func (SavingGoalMovement) TableName() string {
+	return "saving_goal_movements"
+}
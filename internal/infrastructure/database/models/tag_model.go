@@ -0,0 +1,57 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type Tag struct {
+	ID        uint   `gorm:"primaryKey"`
+	Name      string `gorm:"not null;size:50;uniqueIndex:idx_tag_user_name"`
+	Color     string `gorm:"size:7"`
+	UserID    uint   `gorm:"not null;uniqueIndex:idx_tag_user_name"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Excluir a tag remove os vínculos com as transações e com as regras
+	Links     []TransactionTag `gorm:"foreignKey:TagID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	RuleLinks []RuleTag        `gorm:"foreignKey:TagID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TransactionTag é a tabela de ligação entre transações e tags
+type TransactionTag struct {
+	TransactionID uint `gorm:"primaryKey"`
+	TagID         uint `gorm:"primaryKey;index"`
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (t *Tag) FromEntity(entity *entities.Tag) {
+	t.ID = entity.ID
+	t.Name = entity.Name
+	t.Color = entity.Color
+	t.UserID = entity.UserID
+	t.CreatedAt = entity.CreatedAt
+	t.UpdatedAt = entity.UpdatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (t *Tag) ToEntity() *entities.Tag {
+	return &entities.Tag{
+		ID:        t.ID,
+		Name:      t.Name,
+		Color:     t.Color,
+		UserID:    t.UserID,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// TableName especifica o nome da tabela
+func (TransactionTag) TableName() string {
+	return "transaction_tags"
+}
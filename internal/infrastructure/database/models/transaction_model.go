@@ -7,24 +7,54 @@ import (
 	"gorm.io/gorm"
 )
 
+// O índice idx_transaction_user_date_id atende a listagem paginada por usuário,
+// ordenada por data e ID
 type Transaction struct {
-	ID          uint      `gorm:"primaryKey"`
-	Description string    `gorm:"not null"`
-	Amount      float64   `gorm:"not null"`
-	Type        string    `gorm:"not null"`
-	Date        time.Time `gorm:"not null"`
-	Paid        bool      `gorm:"default:false"`
-	UserID      uint      `gorm:"not null"`
-	CategoryID  *uint     `gorm:"column:category_id"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID                uint       `gorm:"primaryKey;index:idx_transaction_user_date_id,priority:3"`
+	Description       string     `gorm:"not null"`
+	Notes             string     `gorm:"type:text"`
+	Amount            float64    `gorm:"not null"`
+	Type              string     `gorm:"not null"`
+	Date              time.Time  `gorm:"not null;index:idx_transaction_user_date_id,priority:2"`
+	Paid              bool       `gorm:"default:false"`
+	UserID            uint       `gorm:"not null;index:idx_transaction_user_date_id,priority:1"`
+	CategoryID        *uint      `gorm:"column:category_id"`
+	PayeeID           *uint      `gorm:"column:payee_id;index"`
+	PiggyBankID       *uint      `gorm:"column:piggy_bank_id;index"`
+	AccountID         *uint      `gorm:"column:account_id;index"`
+	InvoiceID         *uint      `gorm:"column:invoice_id;index"`
+	ParentID          *uint      `gorm:"column:parent_id;index"`
+	IsRecurrent       bool       `gorm:"default:false;index"`
+	RecurrenceType    string     `gorm:"default:none"`
+	RecurrenceEnd     *time.Time `gorm:"column:recurrence_end"`
+	InstallmentNumber int        `gorm:"default:0"`
+	InstallmentTotal  int        `gorm:"default:0"`
+	TransferPairID    *uint      `gorm:"column:transfer_pair_id;index"`
+	TransferDirection string     `gorm:"size:3"`
+	ExternalID        string     `gorm:"column:external_id;size:255;index"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         gorm.DeletedAt `gorm:"index"`
+
+	// Linhas de divisão entre categorias
+	Splits []TransactionSplit `gorm:"foreignKey:TransactionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	// Vínculos com as tags
+	TagLinks []TransactionTag `gorm:"foreignKey:TransactionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	// Relacionamentos usados apenas para criar as chaves estrangeiras
+	PiggyBank *SavingGoal  `gorm:"foreignKey:PiggyBankID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Payee     *Payee       `gorm:"foreignKey:PayeeID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Account   *Account     `gorm:"foreignKey:AccountID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Invoice   *Invoice     `gorm:"foreignKey:InvoiceID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Parent    *Transaction `gorm:"foreignKey:ParentID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 }
 
 // FromEntity converte uma entidade de domínio para o modelo GORM
 func (t *Transaction) FromEntity(entity *entities.Transaction) {
 	t.ID = entity.ID
 	t.Description = entity.Description
+	t.Notes = entity.Notes
 	t.Amount = entity.Amount
 	t.Type = string(entity.Type)
 	t.Date = entity.Date
@@ -37,23 +67,79 @@ func (t *Transaction) FromEntity(entity *entities.Transaction) {
 		t.CategoryID = &categoryID
 	}
 
+	t.PayeeID = entity.PayeeID
+	t.PiggyBankID = entity.PiggyBankID
+	t.AccountID = entity.AccountID
+	t.InvoiceID = entity.InvoiceID
+	t.ParentID = entity.ParentID
+	t.IsRecurrent = entity.IsRecurrent
+	t.RecurrenceType = string(entity.RecurrenceType)
+	t.RecurrenceEnd = entity.RecurrenceEnd
+	t.InstallmentNumber = entity.InstallmentNumber
+	t.InstallmentTotal = entity.InstallmentTotal
+	t.TransferPairID = entity.TransferPairID
+	t.TransferDirection = string(entity.TransferDirection)
+	t.ExternalID = entity.ExternalID
+
+	t.Splits = make([]TransactionSplit, len(entity.Splits))
+	for i := range entity.Splits {
+		t.Splits[i].FromEntity(&entity.Splits[i])
+	}
+
+	t.TagLinks = make([]TransactionTag, len(entity.TagIDs))
+	for i, tagID := range entity.TagIDs {
+		t.TagLinks[i] = TransactionTag{TransactionID: entity.ID, TagID: tagID}
+	}
+
 	t.CreatedAt = entity.CreatedAt
 	t.UpdatedAt = entity.UpdatedAt
 }
 
 // ToEntity converte o modelo GORM para uma entidade de domínio
 func (t *Transaction) ToEntity() *entities.Transaction {
+	var splits []entities.TransactionSplit
+	if len(t.Splits) > 0 {
+		splits = make([]entities.TransactionSplit, len(t.Splits))
+		for i := range t.Splits {
+			splits[i] = t.Splits[i].ToEntity()
+		}
+	}
+
+	var tagIDs []uint
+	if len(t.TagLinks) > 0 {
+		tagIDs = make([]uint, len(t.TagLinks))
+		for i, link := range t.TagLinks {
+			tagIDs[i] = link.TagID
+		}
+	}
+
 	return &entities.Transaction{
-		ID:          t.ID,
-		Description: t.Description,
-		Amount:      t.Amount,
-		Type:        entities.TransactionType(t.Type),
-		Date:        t.Date,
-		Paid:        t.Paid,
-		UserID:      t.UserID,
-		CategoryID:  t.CategoryID,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
+		ID:                t.ID,
+		Description:       t.Description,
+		Notes:             t.Notes,
+		Amount:            t.Amount,
+		Type:              entities.TransactionType(t.Type),
+		Date:              t.Date,
+		Paid:              t.Paid,
+		UserID:            t.UserID,
+		CategoryID:        t.CategoryID,
+		PayeeID:           t.PayeeID,
+		PiggyBankID:       t.PiggyBankID,
+		AccountID:         t.AccountID,
+		InvoiceID:         t.InvoiceID,
+		ParentID:          t.ParentID,
+		IsRecurrent:       t.IsRecurrent,
+		RecurrenceType:    entities.RecurrenceType(t.RecurrenceType),
+		RecurrenceEnd:     t.RecurrenceEnd,
+		InstallmentNumber: t.InstallmentNumber,
+		InstallmentTotal:  t.InstallmentTotal,
+		TransferPairID:    t.TransferPairID,
+		TransferDirection: entities.TransferDirection(t.TransferDirection),
+		Splits:            splits,
+		TagIDs:            tagIDs,
+		ExternalID:        t.ExternalID,
+		CreatedAt:         t.CreatedAt,
+		UpdatedAt:         t.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+type TransactionSplit struct {
+	ID            uint    `gorm:"primaryKey"`
+	TransactionID uint    `gorm:"not null;index"`
+	CategoryID    uint    `gorm:"not null;index"`
+	Amount        float64 `gorm:"not null"`
+	Memo          string  `gorm:"size:255"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// FromEntity converte uma entidade de domínio para o modelo GORM
+func (s *TransactionSplit) FromEntity(entity *entities.TransactionSplit) {
+	s.ID = entity.ID
+	s.TransactionID = entity.TransactionID
+	s.CategoryID = entity.CategoryID
+	s.Amount = entity.Amount
+	s.Memo = entity.Memo
+	s.CreatedAt = entity.CreatedAt
+	s.UpdatedAt = entity.UpdatedAt
+}
+
+// ToEntity converte o modelo GORM para uma entidade de domínio
+func (s *TransactionSplit) ToEntity() entities.TransactionSplit {
+	return entities.TransactionSplit{
+		ID:            s.ID,
+		TransactionID: s.TransactionID,
+		CategoryID:    s.CategoryID,
+		Amount:        s.Amount,
+		Memo:          s.Memo,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+}
+
+// TableName especifica o nome da tabela
+func (TransactionSplit) TableName() string {
+	return "transaction_splits"
+}
@@ -0,0 +1,181 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// signedAmountSQL converte o valor da transação no efeito sobre o saldo da conta
+const signedAmountSQL = `CASE
+	WHEN t.type = 'income' OR (t.type = 'transfer' AND t.transfer_direction = 'in') THEN t.amount
+	WHEN t.type IN ('expense', 'investment') OR (t.type = 'transfer' AND t.transfer_direction = 'out') THEN -t.amount
+	ELSE 0
+END`
+
+type accountRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAccountRepository(db *gorm.DB) repositories.AccountRepository {
+	return &accountRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *accountRepositoryImpl) Create(ctx context.Context, account *entities.Account) error {
+	model := &models.Account{}
+	model.FromEntity(account)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	account.ID = model.ID
+	account.CreatedAt = model.CreatedAt
+	account.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *accountRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Account, error) {
+	var model models.Account
+
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *accountRepositoryImpl) GetByUserID(ctx context.Context, userID uint, includeArchived bool) ([]*entities.Account, error) {
+	var models []models.Account
+
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if !includeArchived {
+		query = query.Where("archived = ?", false)
+	}
+
+	if err := query.Order("name ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*entities.Account, len(models))
+	for i, model := range models {
+		accounts[i] = model.ToEntity()
+	}
+
+	return accounts, nil
+}
+
+func (r *accountRepositoryImpl) Update(ctx context.Context, account *entities.Account) error {
+	model := &models.Account{}
+	model.FromEntity(account)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o timestamp
+	account.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *accountRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Account{}, id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgErrors.ErrAccountNotFound
+	}
+
+	return nil
+}
+
+func (r *accountRepositoryImpl) ExistsByName(ctx context.Context, userID uint, name string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Account{}).
+		Where("user_id = ? AND name = ?", userID, name).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *accountRepositoryImpl) HasTransactions(ctx context.Context, id uint) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("account_id = ?", id).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *accountRepositoryImpl) GetBalances(ctx context.Context, userID uint, until *time.Time) ([]repositories.AccountBalance, error) {
+	var balances []repositories.AccountBalance
+
+	// Receitas e transferências recebidas somam ao saldo; despesas, investimentos
+	// e transferências enviadas saem da conta
+	query := `
+		SELECT
+			a.id AS account_id,
+			a.name AS account_name,
+			a.kind AS kind,
+			a.currency AS currency,
+			a.opening_balance AS opening_balance,
+			COALESCE(SUM(CASE WHEN t.type = 'income' AND t.paid THEN t.amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN t.type IN ('expense', 'investment') AND t.paid THEN t.amount ELSE 0 END), 0) AS expense,
+			COALESCE(SUM(CASE WHEN t.type = 'transfer' AND t.transfer_direction = 'in' AND t.paid THEN t.amount ELSE 0 END), 0) AS transfers_in,
+			COALESCE(SUM(CASE WHEN t.type = 'transfer' AND t.transfer_direction = 'out' AND t.paid THEN t.amount ELSE 0 END), 0) AS transfers_out,
+			a.opening_balance + COALESCE(SUM(CASE WHEN t.paid THEN ` + signedAmountSQL + ` ELSE 0 END), 0) AS balance,
+			a.opening_balance + COALESCE(SUM(` + signedAmountSQL + `), 0) AS projected_balance
+		FROM
+			accounts a
+		LEFT JOIN
+			transactions t ON t.account_id = a.id
+			AND t.deleted_at IS NULL
+	`
+
+	params := []interface{}{}
+	if until != nil && !until.IsZero() {
+		query += " AND t.date <= ?"
+		params = append(params, *until)
+	}
+
+	query += `
+		WHERE
+			a.user_id = ?
+			AND a.deleted_at IS NULL
+			AND a.archived = false
+		GROUP BY
+			a.id, a.name, a.kind, a.currency, a.opening_balance
+		ORDER BY
+			a.name
+	`
+	params = append(params, userID)
+
+	if err := r.db.WithContext(ctx).Raw(query, params...).Scan(&balances).Error; err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+type attachmentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) repositories.AttachmentRepository {
+	return &attachmentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *attachmentRepositoryImpl) Create(ctx context.Context, attachment *entities.Attachment) error {
+	model := &models.Attachment{}
+	model.FromEntity(attachment)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	attachment.ID = model.ID
+	attachment.CreatedAt = model.CreatedAt
+
+	return nil
+}
+
+func (r *attachmentRepositoryImpl) CreateWithinQuota(ctx context.Context, attachment *entities.Attachment, quota int64) (int64, error) {
+	var used int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// O lock de transação é liberado automaticamente no commit ou rollback
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", attachmentLockKey(attachment.UserID)).Error; err != nil {
+			return err
+		}
+
+		txRepo := &attachmentRepositoryImpl{db: tx}
+
+		var err error
+		if used, err = txRepo.GetTotalSizeByUser(ctx, attachment.UserID); err != nil {
+			return err
+		}
+		if used+attachment.Size > quota {
+			return pkgErrors.ErrAttachmentQuota
+		}
+
+		return txRepo.Create(ctx, attachment)
+	})
+	if err != nil {
+		return used, err
+	}
+
+	return used, nil
+}
+
+// attachmentLockKey deriva a chave do advisory lock da cota de anexos do usuário
+func attachmentLockKey(userID uint) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("attachments:" + strconv.FormatUint(uint64(userID), 10)))
+	return int64(h.Sum64())
+}
+
+func (r *attachmentRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Attachment, error) {
+	var model models.Attachment
+
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *attachmentRepositoryImpl) GetByTransactionID(ctx context.Context, transactionID uint) ([]*entities.Attachment, error) {
+	var models []models.Attachment
+
+	if err := r.db.WithContext(ctx).
+		Where("transaction_id = ?", transactionID).
+		Order("created_at ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*entities.Attachment, len(models))
+	for i, model := range models {
+		attachments[i] = model.ToEntity()
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Attachment{}, id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgErrors.ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+func (r *attachmentRepositoryImpl) GetTotalSizeByUser(ctx context.Context, userID uint) (int64, error) {
+	var total int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Attachment{}).
+		Where("user_id = ?", userID).
+		Select("COALESCE(SUM(size), 0)").
+		Scan(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type budgetRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBudgetRepository(db *gorm.DB) repositories.BudgetRepository {
+	return &budgetRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *budgetRepositoryImpl) Create(ctx context.Context, budget *entities.Budget) error {
+	model := &models.Budget{}
+	model.FromEntity(budget)
+
+	if err := r.db.WithContext(ctx).Omit("Category").Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	budget.ID = model.ID
+	budget.CreatedAt = model.CreatedAt
+	budget.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *budgetRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Budget, error) {
+	var model models.Budget
+
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrBudgetNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *budgetRepositoryImpl) GetByUserID(ctx context.Context, userID uint) ([]*entities.Budget, error) {
+	var models []models.Budget
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	budgets := make([]*entities.Budget, len(models))
+	for i, model := range models {
+		budgets[i] = model.ToEntity()
+	}
+
+	return budgets, nil
+}
+
+func (r *budgetRepositoryImpl) Update(ctx context.Context, budget *entities.Budget) error {
+	model := &models.Budget{}
+	model.FromEntity(budget)
+
+	if err := r.db.WithContext(ctx).Omit("Category").Save(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o timestamp
+	budget.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *budgetRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Budget{}, id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgErrors.ErrBudgetNotFound
+	}
+
+	return nil
+}
+
+func (r *budgetRepositoryImpl) ExistsByCategory(ctx context.Context, userID, categoryID uint) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Budget{}).
+		Where("user_id = ? AND category_id = ?", userID, categoryID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+type envelopeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEnvelopeRepository(db *gorm.DB) repositories.EnvelopeRepository {
+	return &envelopeRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *envelopeRepositoryImpl) GetSettings(ctx context.Context, userID uint) (*entities.EnvelopeSettings, error) {
+	var model models.EnvelopeSettings
+
+	if err := r.db.WithContext(ctx).First(&model, "user_id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *envelopeRepositoryImpl) CreateSettings(ctx context.Context, settings *entities.EnvelopeSettings) error {
+	model := &models.EnvelopeSettings{}
+	model.FromEntity(settings)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	settings.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *envelopeRepositoryImpl) DeleteSettings(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Exclusão lógica: o razão deixa de valer, mas o histórico é mantido
+		if err := tx.Where("user_id = ?", userID).Delete(&models.EnvelopeEntry{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&models.EnvelopeSettings{}).Error
+	})
+}
+
+func (r *envelopeRepositoryImpl) GetEntries(ctx context.Context, userID uint, filters *repositories.EnvelopeEntryFilters) ([]*entities.EnvelopeEntry, error) {
+	var models []models.EnvelopeEntry
+
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if filters != nil {
+		if filters.Month != nil {
+			query = query.Where("month = ?", entities.MonthStart(*filters.Month))
+		}
+		if filters.CategoryID != nil {
+			query = query.Where("category_id = ?", *filters.CategoryID)
+		}
+	}
+
+	if err := query.Order("id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	entries := make([]*entities.EnvelopeEntry, len(models))
+	for i, model := range models {
+		entries[i] = model.ToEntity()
+	}
+
+	return entries, nil
+}
+
+func (r *envelopeRepositoryImpl) CreateEntries(ctx context.Context, entries []*entities.EnvelopeEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	models := make([]models.EnvelopeEntry, len(entries))
+	for i, entry := range entries {
+		models[i].FromEntity(entry)
+	}
+
+	if err := r.db.WithContext(ctx).Create(&models).Error; err != nil {
+		return err
+	}
+
+	// Atualiza as entidades com os IDs gerados
+	for i, entry := range entries {
+		entry.ID = models[i].ID
+		entry.CreatedAt = models[i].CreatedAt
+	}
+
+	return nil
+}
+
+func (r *envelopeRepositoryImpl) RunLocked(ctx context.Context, userID uint, fn func(repo repositories.EnvelopeRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// O lock de transação é liberado automaticamente no commit ou rollback
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", envelopeLockKey(userID)).Error; err != nil {
+			return err
+		}
+		return fn(&envelopeRepositoryImpl{db: tx})
+	})
+}
+
+// envelopeLockKey deriva a chave do advisory lock do razão de envelopes do usuário
+func envelopeLockKey(userID uint) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("envelopes:" + strconv.FormatUint(uint64(userID), 10)))
+	return int64(h.Sum64())
+}
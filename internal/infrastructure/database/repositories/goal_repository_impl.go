@@ -40,7 +40,7 @@ func (r *goalRepositoryImpl) Create(ctx context.Context, goal *entities.Goal) er
 func (r *goalRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Goal, error) {
 	var model models.Goal
 
-	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+	if err := r.withLinks(r.db.WithContext(ctx)).First(&model, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, pkgErrors.ErrGoalNotFound
 		}
@@ -53,7 +53,7 @@ func (r *goalRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Go
 func (r *goalRepositoryImpl) GetByUserID(ctx context.Context, userID uint) ([]*entities.Goal, error) {
 	var models []models.Goal
 
-	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error; err != nil {
+	if err := r.withLinks(r.db.WithContext(ctx)).Where("user_id = ?", userID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 
@@ -69,7 +69,41 @@ func (r *goalRepositoryImpl) Update(ctx context.Context, goal *entities.Goal) er
 	model := &models.Goal{}
 	model.FromEntity(goal)
 
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("CategoryLinks", "TagLinks", "AccountLinks").Save(model).Error; err != nil {
+			return err
+		}
+
+		// Os vínculos da meta são sempre regravados para refletir a entidade
+		if err := tx.Where("goal_id = ?", model.ID).Delete(&models.GoalCategory{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("goal_id = ?", model.ID).Delete(&models.GoalTag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("goal_id = ?", model.ID).Delete(&models.GoalAccount{}).Error; err != nil {
+			return err
+		}
+
+		if len(model.CategoryLinks) > 0 {
+			if err := tx.Create(&model.CategoryLinks).Error; err != nil {
+				return err
+			}
+		}
+		if len(model.TagLinks) > 0 {
+			if err := tx.Create(&model.TagLinks).Error; err != nil {
+				return err
+			}
+		}
+		if len(model.AccountLinks) > 0 {
+			if err := tx.Create(&model.AccountLinks).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -79,6 +113,10 @@ func (r *goalRepositoryImpl) Update(ctx context.Context, goal *entities.Goal) er
 	return nil
 }
 
+func (r *goalRepositoryImpl) withLinks(query *gorm.DB) *gorm.DB {
+	return query.Preload("CategoryLinks").Preload("TagLinks").Preload("AccountLinks")
+}
+
 func (r *goalRepositoryImpl) Delete(ctx context.Context, id uint) error {
 	result := r.db.WithContext(ctx).Delete(&models.Goal{}, id)
 
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type importProfileRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewImportProfileRepository(db *gorm.DB) repositories.ImportProfileRepository {
+	return &importProfileRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *importProfileRepositoryImpl) Create(ctx context.Context, profile *entities.ImportProfile) error {
+	model := &models.ImportProfile{}
+	model.FromEntity(profile)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	profile.ID = model.ID
+	profile.CreatedAt = model.CreatedAt
+	profile.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *importProfileRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.ImportProfile, error) {
+	var model models.ImportProfile
+
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrImportProfileNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *importProfileRepositoryImpl) GetByUserID(ctx context.Context, userID uint) ([]*entities.ImportProfile, error) {
+	var models []models.ImportProfile
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*entities.ImportProfile, len(models))
+	for i, model := range models {
+		profiles[i] = model.ToEntity()
+	}
+
+	return profiles, nil
+}
+
+func (r *importProfileRepositoryImpl) Update(ctx context.Context, profile *entities.ImportProfile) error {
+	model := &models.ImportProfile{}
+	model.FromEntity(profile)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o timestamp
+	profile.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *importProfileRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.ImportProfile{}, id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgErrors.ErrImportProfileNotFound
+	}
+
+	return nil
+}
+
+func (r *importProfileRepositoryImpl) ExistsByName(ctx context.Context, userID uint, name string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&models.ImportProfile{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, name).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
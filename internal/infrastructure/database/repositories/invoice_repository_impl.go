@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"math"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// invoiceTotalSQL soma as compras da fatura descontando estornos (receitas no cartão)
+const invoiceTotalSQL = `invoices.*, COALESCE((
+	SELECT SUM(CASE WHEN t.type = 'expense' THEN t.amount WHEN t.type = 'income' THEN -t.amount ELSE 0 END)
+	FROM transactions t
+	WHERE t.invoice_id = invoices.id AND t.deleted_at IS NULL
+), 0) AS total`
+
+type invoiceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) repositories.InvoiceRepository {
+	return &invoiceRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *invoiceRepositoryImpl) GetOrCreate(ctx context.Context, invoice *entities.Invoice) error {
+	model := &models.Invoice{}
+	model.FromEntity(invoice)
+
+	if err := r.db.WithContext(ctx).
+		Where(models.Invoice{AccountID: invoice.AccountID, Year: invoice.Year, Month: invoice.Month}).
+		FirstOrCreate(model).Error; err != nil {
+		return err
+	}
+
+	*invoice = *model.ToEntity()
+
+	return nil
+}
+
+func (r *invoiceRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Invoice, error) {
+	var model models.Invoice
+
+	if err := r.db.WithContext(ctx).Select(invoiceTotalSQL).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *invoiceRepositoryImpl) GetByAccountID(ctx context.Context, accountID uint) ([]*entities.Invoice, error) {
+	var models []models.Invoice
+
+	if err := r.db.WithContext(ctx).
+		Select(invoiceTotalSQL).
+		Where("account_id = ?", accountID).
+		Order("year DESC, month DESC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	invoices := make([]*entities.Invoice, len(models))
+	for i, model := range models {
+		invoices[i] = model.ToEntity()
+	}
+
+	return invoices, nil
+}
+
+func (r *invoiceRepositoryImpl) Pay(ctx context.Context, invoiceID uint, debit, credit *entities.Transaction, paidAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// O bloqueio impede que dois pagamentos simultâneos sejam registrados
+		var invoice models.Invoice
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&invoice, invoiceID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgErrors.ErrInvoiceNotFound
+			}
+			return err
+		}
+		if invoice.PaidAt != nil {
+			return pkgErrors.ErrInvoiceAlreadyPaid
+		}
+
+		// Os lançamentos são lidos aqui para que o valor pago corresponda exatamente
+		// aos itens baixados, mesmo que a fatura tenha mudado desde a consulta
+		var items []models.Transaction
+		if err := tx.Select("id", "type", "amount").
+			Where("invoice_id = ?", invoiceID).
+			Find(&items).Error; err != nil {
+			return err
+		}
+
+		var totalCents int64
+		itemIDs := make([]uint, len(items))
+		for i, item := range items {
+			itemIDs[i] = item.ID
+			switch entities.TransactionType(item.Type) {
+			case entities.EXPENSE:
+				totalCents += int64(math.Round(item.Amount * 100))
+			case entities.INCOME:
+				totalCents -= int64(math.Round(item.Amount * 100))
+			}
+		}
+		if totalCents <= 0 {
+			return pkgErrors.NewDomainError("validation_error", "Fatura não possui valor a pagar")
+		}
+
+		debit.Amount = float64(totalCents) / 100
+		credit.Amount = debit.Amount
+		if err := createTransferLegs(tx, debit, credit); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Invoice{}).
+			Where("id = ?", invoiceID).
+			Updates(map[string]interface{}{
+				"paid_at":                paidAt,
+				"payment_transaction_id": debit.ID,
+				"updated_at":             time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Transaction{}).
+			Where("id IN ?", itemIDs).
+			Updates(map[string]interface{}{
+				"paid":       true,
+				"updated_at": time.Now(),
+			}).Error
+	})
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+
+	"gorm.io/gorm"
+)
+
+type jobRunRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewJobRunRepository(db *gorm.DB) repositories.JobRunRepository {
+	return &jobRunRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *jobRunRepositoryImpl) Create(ctx context.Context, run *entities.JobRun) error {
+	model := &models.JobRun{}
+	model.FromEntity(run)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	run.ID = model.ID
+
+	return nil
+}
+
+func (r *jobRunRepositoryImpl) Update(ctx context.Context, run *entities.JobRun) error {
+	model := &models.JobRun{}
+	model.FromEntity(run)
+
+	return r.db.WithContext(ctx).Save(model).Error
+}
+
+func (r *jobRunRepositoryImpl) GetRecentByJob(ctx context.Context, jobName string, limit int) ([]*entities.JobRun, error) {
+	var models []models.JobRun
+
+	if err := r.db.WithContext(ctx).
+		Where("job_name = ?", jobName).
+		Order("started_at DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	runs := make([]*entities.JobRun, len(models))
+	for i, model := range models {
+		runs[i] = model.ToEntity()
+	}
+
+	return runs, nil
+}
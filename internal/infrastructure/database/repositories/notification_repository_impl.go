@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repositories.NotificationRepository {
+	return &notificationRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *notificationRepositoryImpl) CreateIfAbsent(ctx context.Context, notification *entities.Notification) (bool, error) {
+	model := &models.Notification{}
+	model.FromEntity(notification)
+
+	// A chave única (user_id, dedup_key) descarta o mesmo alerta gerado em paralelo
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "dedup_key"}},
+			DoNothing: true,
+		}).
+		Create(model)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	// Atualiza a entidade com o ID gerado
+	notification.ID = model.ID
+	notification.CreatedAt = model.CreatedAt
+
+	return true, nil
+}
+
+func (r *notificationRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Notification, error) {
+	var model models.Notification
+
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrNotificationNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *notificationRepositoryImpl) GetByUserID(ctx context.Context, userID uint, unreadOnly bool, limit int) ([]*entities.Notification, error) {
+	var models []models.Notification
+
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*entities.Notification, len(models))
+	for i, model := range models {
+		notifications[i] = model.ToEntity()
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationRepositoryImpl) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *notificationRepositoryImpl) Update(ctx context.Context, notification *entities.Notification) error {
+	model := &models.Notification{}
+	model.FromEntity(notification)
+
+	return r.db.WithContext(ctx).Save(model).Error
+}
+
+func (r *notificationRepositoryImpl) MarkAllRead(ctx context.Context, userID uint) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *notificationRepositoryImpl) GetSettings(ctx context.Context, userID uint) (*entities.NotificationSettings, error) {
+	var model models.NotificationSettings
+
+	if err := r.db.WithContext(ctx).First(&model, "user_id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *notificationRepositoryImpl) SaveSettings(ctx context.Context, settings *entities.NotificationSettings) error {
+	model := &models.NotificationSettings{}
+	model.FromEntity(settings)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	settings.UpdatedAt = model.UpdatedAt
+	return nil
+}
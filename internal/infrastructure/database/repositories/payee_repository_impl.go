@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type payeeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPayeeRepository(db *gorm.DB) repositories.PayeeRepository {
+	return &payeeRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *payeeRepositoryImpl) Create(ctx context.Context, payee *entities.Payee) error {
+	model := &models.Payee{}
+	model.FromEntity(payee)
+
+	if err := r.db.WithContext(ctx).Omit("DefaultCategory").Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	payee.ID = model.ID
+	payee.CreatedAt = model.CreatedAt
+	payee.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *payeeRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Payee, error) {
+	var model models.Payee
+
+	if err := r.db.WithContext(ctx).Preload("AliasLinks").First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrPayeeNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *payeeRepositoryImpl) GetByUserID(ctx context.Context, userID uint) ([]*entities.Payee, error) {
+	var models []models.Payee
+
+	if err := r.db.WithContext(ctx).
+		Preload("AliasLinks").
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	payees := make([]*entities.Payee, len(models))
+	for i, model := range models {
+		payees[i] = model.ToEntity()
+	}
+
+	return payees, nil
+}
+
+func (r *payeeRepositoryImpl) Update(ctx context.Context, payee *entities.Payee) error {
+	model := &models.Payee{}
+	model.FromEntity(payee)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("AliasLinks", "DefaultCategory").Save(model).Error; err != nil {
+			return err
+		}
+
+		// Os apelidos são sempre regravados para refletir a entidade
+		if err := tx.Where("payee_id = ?", model.ID).Delete(&models.PayeeAlias{}).Error; err != nil {
+			return err
+		}
+		if len(model.AliasLinks) > 0 {
+			if err := tx.Create(&model.AliasLinks).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o timestamp
+	payee.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *payeeRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Payee{}, id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgErrors.ErrPayeeNotFound
+	}
+
+	return nil
+}
+
+func (r *payeeRepositoryImpl) ExistsByName(ctx context.Context, userID uint, name string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Payee{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, name).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type ruleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRuleRepository(db *gorm.DB) repositories.RuleRepository {
+	return &ruleRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ruleRepositoryImpl) Create(ctx context.Context, rule *entities.Rule) error {
+	model := &models.Rule{}
+	model.FromEntity(rule)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	rule.ID = model.ID
+	rule.CreatedAt = model.CreatedAt
+	rule.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *ruleRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Rule, error) {
+	var model models.Rule
+
+	if err := r.db.WithContext(ctx).Preload("TagLinks").First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrRuleNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *ruleRepositoryImpl) GetByUserID(ctx context.Context, userID uint) ([]*entities.Rule, error) {
+	return r.find(ctx, r.db.WithContext(ctx).Where("user_id = ?", userID))
+}
+
+func (r *ruleRepositoryImpl) GetActiveByUserID(ctx context.Context, userID uint) ([]*entities.Rule, error) {
+	return r.find(ctx, r.db.WithContext(ctx).Where("user_id = ? AND active = ?", userID, true))
+}
+
+func (r *ruleRepositoryImpl) find(ctx context.Context, query *gorm.DB) ([]*entities.Rule, error) {
+	var models []models.Rule
+
+	if err := query.Preload("TagLinks").Order("priority ASC, id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	rules := make([]*entities.Rule, len(models))
+	for i, model := range models {
+		rules[i] = model.ToEntity()
+	}
+
+	return rules, nil
+}
+
+func (r *ruleRepositoryImpl) Update(ctx context.Context, rule *entities.Rule) error {
+	model := &models.Rule{}
+	model.FromEntity(rule)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("TagLinks", "Account", "SetPayee").Save(model).Error; err != nil {
+			return err
+		}
+
+		// As tags da regra são sempre regravadas para refletir a entidade
+		if err := tx.Where("rule_id = ?", model.ID).Delete(&models.RuleTag{}).Error; err != nil {
+			return err
+		}
+		if len(model.TagLinks) > 0 {
+			if err := tx.Create(&model.TagLinks).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o timestamp
+	rule.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *ruleRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Rule{}, id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgErrors.ErrRuleNotFound
+	}
+
+	return nil
+}
@@ -3,7 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
-	"log"
+	"math"
 	"my-finance-hub-api/internal/domain/entities"
 	"my-finance-hub-api/internal/domain/repositories"
 	"my-finance-hub-api/internal/infrastructure/database/models"
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type savingGoalRepositoryImpl struct {
@@ -23,11 +24,30 @@ func NewSavingGoalRepository(db *gorm.DB) repositories.SavingGoalRepository {
 	}
 }
 
-func (r *savingGoalRepositoryImpl) Create(ctx context.Context, savingGoal *entities.SavingGoal) error {
+func (r *savingGoalRepositoryImpl) Create(ctx context.Context, savingGoal *entities.SavingGoal, opening *entities.SavingGoalMovement) error {
 	model := &models.SavingGoal{}
 	model.FromEntity(savingGoal)
 
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		if opening == nil {
+			return nil
+		}
+
+		opening.SavingGoalID = model.ID
+		movementModel := &models.SavingGoalMovement{}
+		movementModel.FromEntity(opening)
+		if err := tx.Omit("SavingGoal").Create(movementModel).Error; err != nil {
+			return err
+		}
+		opening.ID = movementModel.ID
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -67,16 +87,48 @@ func (r *savingGoalRepositoryImpl) GetByUserID(ctx context.Context, userID uint)
 	return savingGoals, nil
 }
 
-func (r *savingGoalRepositoryImpl) Update(ctx context.Context, savingGoal *entities.SavingGoal) error {
+func (r *savingGoalRepositoryImpl) Update(ctx context.Context, savingGoal *entities.SavingGoal, adjustment *entities.SavingGoalMovement) error {
 	model := &models.SavingGoal{}
 	model.FromEntity(savingGoal)
 
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+	var balance float64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// O saldo é lido com a meta bloqueada, para que o ajuste considere movimentações
+		// gravadas desde a consulta do serviço
+		var locked models.SavingGoal
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&locked, model.ID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgErrors.ErrSavingGoalNotFound
+			}
+			return err
+		}
+		balance = locked.CurrentAmount
+
+		if err := tx.Omit("current_amount").Save(model).Error; err != nil {
+			return err
+		}
+
+		if adjustment == nil {
+			return nil
+		}
+
+		difference := math.Round((savingGoal.CurrentAmount-balance)*100) / 100
+		if difference == 0 {
+			return nil
+		}
+
+		adjustment.Amount = difference
+		var err error
+		balance, err = addMovement(tx, adjustment)
+		return err
+	})
+	if err != nil {
 		return err
 	}
 
-	// Atualiza a entidade com o timestamp
+	// Atualiza a entidade com o timestamp e o saldo
 	savingGoal.UpdatedAt = model.UpdatedAt
+	savingGoal.CurrentAmount = balance
 
 	return nil
 }
@@ -95,34 +147,78 @@ func (r *savingGoalRepositoryImpl) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
-func (r *savingGoalRepositoryImpl) UpdateAmount(ctx context.Context, id uint, newAmount float64) error {
-	// Primeiro, verificar se o registro existe
-	var existingSavingGoal models.SavingGoal
-	if err := r.db.WithContext(ctx).First(&existingSavingGoal, id).Error; err != nil {
+func (r *savingGoalRepositoryImpl) AddMovement(ctx context.Context, movement *entities.SavingGoalMovement) (float64, error) {
+	var balance float64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		balance, err = addMovement(tx, movement)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// addMovement grava a movimentação em tx e retorna o novo saldo da meta
+func addMovement(tx *gorm.DB, movement *entities.SavingGoalMovement) (float64, error) {
+	// Bloquear a meta para que movimentações simultâneas não passem do saldo
+	var savingGoal models.SavingGoal
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&savingGoal, movement.SavingGoalID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return pkgErrors.ErrSavingGoalNotFound
+			return 0, pkgErrors.ErrSavingGoalNotFound
 		}
-		return err
+		return 0, err
 	}
 
-	// Atualizar o valor
-	result := r.db.WithContext(ctx).Model(&models.SavingGoal{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"current_amount": newAmount,
-			"updated_at":     time.Now(),
-		})
+	model := &models.SavingGoalMovement{}
+	model.FromEntity(movement)
+	if err := tx.Omit("SavingGoal").Create(model).Error; err != nil {
+		return 0, err
+	}
 
-	if result.Error != nil {
-		log.Printf("Erro ao atualizar valor do cofrinho: %v", result.Error)
-		return result.Error
+	var balance float64
+	if err := tx.Model(&models.SavingGoalMovement{}).
+		Where("saving_goal_id = ?", movement.SavingGoalID).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&balance).Error; err != nil {
+		return 0, err
 	}
+	balance = math.Round(balance*100) / 100
 
-	if result.RowsAffected == 0 {
-		log.Printf("Nenhuma linha atualizada para o cofrinho ID %d", id)
-		return pkgErrors.ErrSavingGoalNotFound
+	if balance < 0 {
+		return 0, pkgErrors.ErrInsufficientFunds
 	}
 
-	log.Printf("Cofrinho ID %d atualizado com sucesso. Novo valor: %.2f", id, newAmount)
-	return nil
+	if err := tx.Model(&savingGoal).Updates(map[string]interface{}{
+		"current_amount": balance,
+		"updated_at":     time.Now(),
+	}).Error; err != nil {
+		return 0, err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	movement.ID = model.ID
+	movement.CreatedAt = model.CreatedAt
+
+	return balance, nil
+}
+
+func (r *savingGoalRepositoryImpl) GetMovements(ctx context.Context, savingGoalID uint) ([]*entities.SavingGoalMovement, error) {
+	var models []models.SavingGoalMovement
+
+	if err := r.db.WithContext(ctx).
+		Where("saving_goal_id = ?", savingGoalID).
+		Order("date ASC, id ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	movements := make([]*entities.SavingGoalMovement, len(models))
+	for i, model := range models {
+		movements[i] = model.ToEntity()
+	}
+
+	return movements, nil
 }
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	"my-finance-hub-api/internal/infrastructure/database/models"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type tagRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) repositories.TagRepository {
+	return &tagRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *tagRepositoryImpl) Create(ctx context.Context, tag *entities.Tag) error {
+	model := &models.Tag{}
+	model.FromEntity(tag)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o ID gerado
+	tag.ID = model.ID
+	tag.CreatedAt = model.CreatedAt
+	tag.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *tagRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Tag, error) {
+	var model models.Tag
+
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgErrors.ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *tagRepositoryImpl) GetByIDs(ctx context.Context, ids []uint) ([]*entities.Tag, error) {
+	var models []models.Tag
+
+	if len(ids) == 0 {
+		return []*entities.Tag{}, nil
+	}
+
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entities.Tag, len(models))
+	for i, model := range models {
+		tags[i] = model.ToEntity()
+	}
+
+	return tags, nil
+}
+
+func (r *tagRepositoryImpl) GetByUserID(ctx context.Context, userID uint) ([]*entities.Tag, error) {
+	var models []models.Tag
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entities.Tag, len(models))
+	for i, model := range models {
+		tags[i] = model.ToEntity()
+	}
+
+	return tags, nil
+}
+
+func (r *tagRepositoryImpl) Update(ctx context.Context, tag *entities.Tag) error {
+	model := &models.Tag{}
+	model.FromEntity(tag)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	// Atualiza a entidade com o timestamp
+	tag.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+func (r *tagRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Tag{}, id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgErrors.ErrTagNotFound
+	}
+
+	return nil
+}
+
+func (r *tagRepositoryImpl) ExistsByName(ctx context.Context, userID uint, name string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Tag{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, name).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
@@ -9,11 +10,28 @@ import (
 	"my-finance-hub-api/internal/domain/repositories"
 	"my-finance-hub-api/internal/infrastructure/database/models"
 	pkgErrors "my-finance-hub-api/pkg/errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// categoryLinesSQL expande as transações em linhas por categoria: transações
+// divididas contribuem com cada linha de divisão, as demais com o próprio valor
+const categoryLinesSQL = `(
+	SELECT t.user_id, t.category_id, t.amount, t.type, t.date, t.paid
+	FROM transactions t
+	WHERE t.deleted_at IS NULL
+		AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = t.id)
+	UNION ALL
+	SELECT t.user_id, s.category_id, s.amount, t.type, t.date, t.paid
+	FROM transaction_splits s
+	JOIN transactions t ON t.id = s.transaction_id
+	WHERE t.deleted_at IS NULL
+)`
+
 type transactionRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -36,6 +54,7 @@ func (r *transactionRepositoryImpl) Create(ctx context.Context, transaction *ent
 	transaction.ID = model.ID
 	transaction.CreatedAt = model.CreatedAt
 	transaction.UpdatedAt = model.UpdatedAt
+	copySplitIDs(transaction, model)
 
 	return nil
 }
@@ -43,7 +62,7 @@ func (r *transactionRepositoryImpl) Create(ctx context.Context, transaction *ent
 func (r *transactionRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Transaction, error) {
 	var model models.Transaction
 
-	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Splits").Preload("TagLinks").First(&model, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, pkgErrors.ErrTransactionNotFound
 		}
@@ -54,31 +73,50 @@ func (r *transactionRepositoryImpl) GetByID(ctx context.Context, id uint) (*enti
 }
 
 func (r *transactionRepositoryImpl) GetByUserID(ctx context.Context, userID uint, filters *repositories.TransactionFilters) ([]*entities.Transaction, error) {
-	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	query := r.db.WithContext(ctx).Preload("Splits").Preload("TagLinks").Where("user_id = ?", userID)
+	query = applyTransactionFilters(query, filters)
 
-	if filters != nil {
-		if filters.Paid != nil {
-			query = query.Where("paid = ?", *filters.Paid)
-		}
-		if filters.Type != nil {
-			query = query.Where("type = ?", *filters.Type)
-		}
-		if filters.CategoryID != nil {
-			query = query.Where("category_id = ?", *filters.CategoryID)
-		}
-		if filters.Month != nil && filters.Year != nil {
-			query = query.Where("EXTRACT(MONTH FROM date) = ? AND EXTRACT(YEAR FROM date) = ?", *filters.Month, *filters.Year)
-		}
+	var models []models.Transaction
+	if err := query.Order("date DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entities.Transaction, len(models))
+	for i, model := range models {
+		transactions[i] = model.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+func (r *transactionRepositoryImpl) ListByUserID(ctx context.Context, userID uint, filters *repositories.TransactionFilters, page *repositories.TransactionPageRequest) ([]*entities.Transaction, bool, error) {
+	query := r.db.WithContext(ctx).Preload("Splits").Preload("TagLinks").Where("user_id = ?", userID)
+	query = applyTransactionFilters(query, filters)
+
+	// O campo já foi validado, então pode compor o SQL diretamente
+	column := string(page.SortBy)
+	direction, comparison := "ASC", ">"
+	if page.Descending {
+		direction, comparison = "DESC", "<"
+	}
 
-		// Novo filtro de data
-		if !filters.StartDate.IsZero() && !filters.EndDate.IsZero() {
-			query = query.Where("date BETWEEN ? AND ?", filters.StartDate, filters.EndDate)
+	if page.After != nil {
+		value, err := parseCursorValue(page.After)
+		if err != nil {
+			return nil, false, err
 		}
+		query = query.Where("("+column+", id) "+comparison+" (?, ?)", value, page.After.ID)
 	}
 
+	// Um registro a mais indica se existe próxima página
 	var models []models.Transaction
-	if err := query.Order("date DESC").Find(&models).Error; err != nil {
-		return nil, err
+	if err := query.Order(column + " " + direction + ", id " + direction).Limit(page.Limit + 1).Find(&models).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(models) > page.Limit
+	if hasMore {
+		models = models[:page.Limit]
 	}
 
 	transactions := make([]*entities.Transaction, len(models))
@@ -86,23 +124,212 @@ func (r *transactionRepositoryImpl) GetByUserID(ctx context.Context, userID uint
 		transactions[i] = model.ToEntity()
 	}
 
-	return transactions, nil
+	return transactions, hasMore, nil
+}
+
+// parseCursorValue converte o valor textual do cursor para o tipo da coluna de ordenação
+func parseCursorValue(cursor *repositories.TransactionCursor) (interface{}, error) {
+	errInvalidCursor := pkgErrors.NewDomainError("validation_error", "Cursor de paginação inválido")
+
+	switch cursor.SortBy {
+	case repositories.SORT_BY_AMOUNT:
+		amount, err := strconv.ParseFloat(cursor.Value, 64)
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		return amount, nil
+	case repositories.SORT_BY_DESCRIPTION:
+		return cursor.Value, nil
+	case repositories.SORT_BY_DATE, repositories.SORT_BY_CREATED_AT:
+		value, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		return value, nil
+	default:
+		return nil, errInvalidCursor
+	}
+}
+
+func (r *transactionRepositoryImpl) GetTotals(ctx context.Context, userID uint, filters *repositories.TransactionFilters) (*repositories.TransactionTotals, error) {
+	var totals repositories.TransactionTotals
+
+	query := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Select(`COUNT(*) AS count,
+			COALESCE(SUM(CASE WHEN type = ? THEN amount END), 0) AS income,
+			COALESCE(SUM(CASE WHEN type = ? THEN amount END), 0) AS expense,
+			COALESCE(SUM(CASE WHEN type = ? THEN amount END), 0) AS investment`,
+			entities.INCOME, entities.EXPENSE, entities.INVESTMENT).
+		Where("user_id = ?", userID)
+	query = applyTransactionFilters(query, filters)
+
+	if err := query.Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+
+	return &totals, nil
 }
 
+func (r *transactionRepositoryImpl) StreamByUserID(ctx context.Context, userID uint, filters *repositories.TransactionFilters, batchSize int, fn func([]*entities.Transaction) error) error {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	var lastDate time.Time
+	var lastID uint
+
+	for {
+		query := r.db.WithContext(ctx).Preload("Splits").Preload("TagLinks").Where("user_id = ?", userID)
+		query = applyTransactionFilters(query, filters)
+
+		// Paginação por chave (data, id) para não depender de OFFSET em exportações grandes
+		if lastID != 0 {
+			query = query.Where("(date, id) > (?, ?)", lastDate, lastID)
+		}
+
+		var models []models.Transaction
+		if err := query.Order("date ASC, id ASC").Limit(batchSize).Find(&models).Error; err != nil {
+			return err
+		}
+
+		if len(models) == 0 {
+			return nil
+		}
+
+		transactions := make([]*entities.Transaction, len(models))
+		for i, model := range models {
+			transactions[i] = model.ToEntity()
+		}
+
+		if err := fn(transactions); err != nil {
+			return err
+		}
+
+		if len(models) < batchSize {
+			return nil
+		}
+
+		last := models[len(models)-1]
+		lastDate, lastID = last.Date, last.ID
+	}
+}
+
+// applyTransactionFilters aplica os filtros da listagem de transações à consulta
+func applyTransactionFilters(query *gorm.DB, filters *repositories.TransactionFilters) *gorm.DB {
+	if filters == nil {
+		return query
+	}
+
+	if filters.Paid != nil {
+		query = query.Where("paid = ?", *filters.Paid)
+	}
+	if filters.Type != nil {
+		query = query.Where("type = ?", *filters.Type)
+	}
+	if filters.CategoryID != nil {
+		// Transações divididas entram no filtro se alguma linha for da categoria
+		query = query.Where("category_id = ? OR EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = transactions.id AND s.category_id = ?)",
+			*filters.CategoryID, *filters.CategoryID)
+	}
+	if filters.PayeeID != nil {
+		query = query.Where("payee_id = ?", *filters.PayeeID)
+	}
+	if filters.AccountID != nil {
+		query = query.Where("account_id = ?", *filters.AccountID)
+	}
+	if len(filters.TagIDs) > 0 {
+		if filters.TagMatch == repositories.TAG_MATCH_ALL {
+			query = query.Where("(SELECT COUNT(DISTINCT tt.tag_id) FROM transaction_tags tt WHERE tt.transaction_id = transactions.id AND tt.tag_id IN ?) = ?",
+				filters.TagIDs, len(uniqueIDs(filters.TagIDs)))
+		} else {
+			query = query.Where("EXISTS (SELECT 1 FROM transaction_tags tt WHERE tt.transaction_id = transactions.id AND tt.tag_id IN ?)", filters.TagIDs)
+		}
+	}
+	if filters.Month != nil && filters.Year != nil {
+		query = query.Where("EXTRACT(MONTH FROM date) = ? AND EXTRACT(YEAR FROM date) = ?", *filters.Month, *filters.Year)
+	}
+
+	// Cada limite do período pode ser informado isoladamente
+	if !filters.StartDate.IsZero() {
+		query = query.Where("date >= ?", filters.StartDate)
+	}
+	if !filters.EndDate.IsZero() {
+		query = query.Where("date <= ?", filters.EndDate)
+	}
+
+	if filters.MinAmount != nil {
+		query = query.Where("amount >= ?", *filters.MinAmount)
+	}
+	if filters.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filters.MaxAmount)
+	}
+	if filters.Description != "" {
+		query = query.Where("description ILIKE ?", "%"+likeEscaper.Replace(filters.Description)+"%")
+	}
+
+	return query
+}
+
+// likeEscaper neutraliza os curingas do LIKE no texto informado pelo usuário
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func (r *transactionRepositoryImpl) Update(ctx context.Context, transaction *entities.Transaction) error {
 	model := &models.Transaction{}
 	model.FromEntity(transaction)
 
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Splits", "TagLinks").Save(model).Error; err != nil {
+			return err
+		}
+
+		// As linhas de divisão e as tags são sempre regravadas para refletir a entidade
+		if err := tx.Where("transaction_id = ?", model.ID).Delete(&models.TransactionSplit{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("transaction_id = ?", model.ID).Delete(&models.TransactionTag{}).Error; err != nil {
+			return err
+		}
+
+		if len(model.Splits) > 0 {
+			for i := range model.Splits {
+				model.Splits[i].ID = 0
+				model.Splits[i].TransactionID = model.ID
+			}
+			if err := tx.Create(&model.Splits).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(model.TagLinks) > 0 {
+			for i := range model.TagLinks {
+				model.TagLinks[i].TransactionID = model.ID
+			}
+			if err := tx.Create(&model.TagLinks).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
 	// Atualiza a entidade com o timestamp
 	transaction.UpdatedAt = model.UpdatedAt
+	copySplitIDs(transaction, model)
 
 	return nil
 }
 
+// copySplitIDs atualiza as linhas de divisão da entidade com os IDs gerados
+func copySplitIDs(transaction *entities.Transaction, model *models.Transaction) {
+	for i := range transaction.Splits {
+		transaction.Splits[i].ID = model.Splits[i].ID
+		transaction.Splits[i].TransactionID = model.ID
+	}
+}
+
 func (r *transactionRepositoryImpl) Delete(ctx context.Context, id uint) error {
 	result := r.db.WithContext(ctx).Delete(&models.Transaction{}, id)
 
@@ -117,10 +344,311 @@ func (r *transactionRepositoryImpl) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
+func (r *transactionRepositoryImpl) GetByIDs(ctx context.Context, ids []uint) ([]*entities.Transaction, error) {
+	var models []models.Transaction
+
+	if len(ids) == 0 {
+		return []*entities.Transaction{}, nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Preload("Splits").
+		Preload("TagLinks").
+		Where("id IN ?", ids).
+		Order("date ASC, id ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entities.Transaction, len(models))
+	for i, model := range models {
+		transactions[i] = model.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+func (r *transactionRepositoryImpl) GetDuplicatePairs(ctx context.Context, userID uint, window time.Duration) ([][2]uint, error) {
+	var rows []struct {
+		FirstID  uint
+		SecondID uint
+	}
+
+	// A semelhança das descrições é avaliada depois, no serviço; aqui só se
+	// restringe por tipo, valor (em centavos) e distância entre as datas
+	query := `
+		SELECT a.id AS first_id, b.id AS second_id
+		FROM transactions a
+		JOIN transactions b ON b.user_id = a.user_id
+			AND b.id > a.id
+			AND b.type = a.type
+			AND ROUND(b.amount * 100) = ROUND(a.amount * 100)
+			AND ABS(EXTRACT(EPOCH FROM (b.date - a.date))) <= ?
+		WHERE a.user_id = ?
+			AND a.type <> 'transfer'
+			AND a.deleted_at IS NULL
+			AND b.deleted_at IS NULL
+		ORDER BY a.id, b.id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, window.Seconds(), userID).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	pairs := make([][2]uint, len(rows))
+	for i, row := range rows {
+		pairs[i] = [2]uint{row.FirstID, row.SecondID}
+	}
+
+	return pairs, nil
+}
+
+// searchConfig é a configuração de busca textual criada em SetupDatabase: dicionário
+// português sem acentos
+const searchConfig = "portuguese_unaccent"
+
+// searchContentSQL reúne o texto pesquisável de uma transação
+const searchContentSQL = `concat_ws(' ',
+	transactions.description,
+	transactions.notes,
+	(SELECT p.name FROM payees p WHERE p.id = transactions.payee_id),
+	(SELECT string_agg(tg.name, ' ') FROM transaction_tags tt JOIN tags tg ON tg.id = tt.tag_id WHERE tt.transaction_id = transactions.id))`
+
+// searchMatchSQL encontra as transações em que todos os termos aparecem na descrição
+// e nas observações, pela coluna search_vector indexada (criada em SetupDatabase), no
+// favorecido ou em uma das tags
+const searchMatchSQL = `(transactions.search_vector @@ to_tsquery('` + searchConfig + `', @query)
+	OR transactions.payee_id IN (SELECT p.id FROM payees p WHERE to_tsvector('` + searchConfig + `', p.name) @@ to_tsquery('` + searchConfig + `', @query))
+	OR transactions.id IN (SELECT tt.transaction_id FROM transaction_tags tt JOIN tags tg ON tg.id = tt.tag_id WHERE to_tsvector('` + searchConfig + `', tg.name) @@ to_tsquery('` + searchConfig + `', @query)))`
+
+// searchHeadlineOptions define o trecho destacado devolvido com cada resultado
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=20, MinWords=5, MaxFragments=2"
+
+// escapeHTMLSQL escapa em SQL os caracteres especiais de HTML do texto, para que o
+// trecho devolvido por ts_headline só contenha as marcações <mark> da busca
+func escapeHTMLSQL(expression string) string {
+	for _, replacement := range [][2]string{{"&", "&amp;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&quot;"}, {"'", "&#39;"}} {
+		expression = "replace(" + expression + ", '" + strings.ReplaceAll(replacement[0], "'", "''") + "', '" + replacement[1] + "')"
+	}
+	return expression
+}
+
+func (r *transactionRepositoryImpl) Search(ctx context.Context, userID uint, text string, filters *repositories.TransactionFilters, limit int) ([]*entities.TransactionSearchHit, error) {
+	tsQuery := buildPrefixQuery(text)
+	if tsQuery == "" {
+		return []*entities.TransactionSearchHit{}, nil
+	}
+
+	// O texto completo só é montado para ordenar e destacar as transações encontradas
+	document := "to_tsvector('" + searchConfig + "', " + searchContentSQL + ")"
+	match := "to_tsquery('" + searchConfig + "', @query)"
+
+	var rows []struct {
+		ID      uint
+		Rank    float64
+		Snippet string
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Select("transactions.id, ts_rank("+document+", "+match+") AS rank, ts_headline('"+searchConfig+"', "+escapeHTMLSQL(searchContentSQL)+", "+match+", @options) AS snippet",
+			sql.Named("query", tsQuery), sql.Named("options", searchHeadlineOptions)).
+		Where("transactions.user_id = ?", userID).
+		Where(searchMatchSQL, sql.Named("query", tsQuery))
+	query = applyTransactionFilters(query, filters)
+
+	if err := query.Order("rank DESC, transactions.date DESC, transactions.id DESC").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	transactions, err := r.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]*entities.Transaction, len(transactions))
+	for _, transaction := range transactions {
+		byID[transaction.ID] = transaction
+	}
+
+	hits := make([]*entities.TransactionSearchHit, 0, len(rows))
+	for _, row := range rows {
+		if transaction, ok := byID[row.ID]; ok {
+			hits = append(hits, &entities.TransactionSearchHit{
+				Transaction: transaction,
+				Rank:        row.Rank,
+				Snippet:     row.Snippet,
+			})
+		}
+	}
+
+	return hits, nil
+}
+
+// buildPrefixQuery monta uma consulta em que todos os termos precisam aparecer,
+// cada um como prefixo ("merc" encontra "mercado"). A normalização deixa apenas
+// letras e números, então os termos não carregam operadores do tsquery.
+func buildPrefixQuery(text string) string {
+	words := strings.Fields(entities.NormalizeDescription(text))
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+func (r *transactionRepositoryImpl) GetCategorizationChanges(ctx context.Context, userID uint, since time.Time) ([]repositories.CategorizationChange, error) {
+	var changes []repositories.CategorizationChange
+
+	// Inclui as excluídas (soft delete) para que o modelo também as esqueça
+	query := `
+		SELECT
+			id AS transaction_id,
+			description,
+			category_id,
+			GREATEST(updated_at, COALESCE(deleted_at, updated_at)) AS changed_at,
+			deleted_at IS NOT NULL AS deleted
+		FROM transactions
+		WHERE user_id = ?
+			AND type <> 'transfer'
+			AND (updated_at >= ? OR deleted_at >= ?)
+		ORDER BY changed_at, id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, userID, since, since).Scan(&changes).Error; err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (r *transactionRepositoryImpl) MergeDuplicates(ctx context.Context, keep *entities.Transaction, duplicateIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &transactionRepositoryImpl{db: tx}
+		if err := txRepo.Update(ctx, keep); err != nil {
+			return err
+		}
+
+		// Os comprovantes das duplicatas passam para a transação mantida
+		if err := tx.Model(&models.Attachment{}).
+			Where("transaction_id IN ?", duplicateIDs).
+			Update("transaction_id", keep.ID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id IN ? AND user_id = ?", duplicateIDs, keep.UserID).Delete(&models.Transaction{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(duplicateIDs)) {
+			return pkgErrors.ErrTransactionNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *transactionRepositoryImpl) ApplyBulk(ctx context.Context, userID uint, updates []*entities.Transaction, deleteIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &transactionRepositoryImpl{db: tx}
+		for _, transaction := range updates {
+			if err := txRepo.Update(ctx, transaction); err != nil {
+				return err
+			}
+		}
+
+		if len(deleteIDs) == 0 {
+			return nil
+		}
+
+		result := tx.Where("id IN ? AND user_id = ?", deleteIDs, userID).Delete(&models.Transaction{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(deleteIDs)) {
+			return pkgErrors.ErrTransactionNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *transactionRepositoryImpl) CreateTransfer(ctx context.Context, debit, credit *entities.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createTransferLegs(tx, debit, credit)
+	})
+}
+
+// createTransferLegs grava as duas pernas da transferência em tx e as vincula
+func createTransferLegs(tx *gorm.DB, debit, credit *entities.Transaction) error {
+	debitModel := &models.Transaction{}
+	debitModel.FromEntity(debit)
+	if err := tx.Create(debitModel).Error; err != nil {
+		return err
+	}
+
+	creditModel := &models.Transaction{}
+	creditModel.FromEntity(credit)
+	creditModel.TransferPairID = &debitModel.ID
+	if err := tx.Create(creditModel).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Model(debitModel).Update("transfer_pair_id", creditModel.ID).Error; err != nil {
+		return err
+	}
+
+	// Atualiza as entidades com os IDs gerados e o vínculo entre as pernas
+	debit.ID = debitModel.ID
+	debit.TransferPairID = &creditModel.ID
+	debit.CreatedAt = debitModel.CreatedAt
+	debit.UpdatedAt = debitModel.UpdatedAt
+
+	credit.ID = creditModel.ID
+	credit.TransferPairID = &debitModel.ID
+	credit.CreatedAt = creditModel.CreatedAt
+	credit.UpdatedAt = creditModel.UpdatedAt
+
+	return nil
+}
+
+func (r *transactionRepositoryImpl) UpdateTransfer(ctx context.Context, debit, credit *entities.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, transaction := range []*entities.Transaction{debit, credit} {
+			model := &models.Transaction{}
+			model.FromEntity(transaction)
+			if err := tx.Omit("Splits", "TagLinks").Save(model).Error; err != nil {
+				return err
+			}
+			transaction.UpdatedAt = model.UpdatedAt
+		}
+		return nil
+	})
+}
+
+func (r *transactionRepositoryImpl) DeleteTransfer(ctx context.Context, debitID, creditID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.Transaction{}, []uint{debitID, creditID})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != 2 {
+			return pkgErrors.ErrTransactionNotFound
+		}
+
+		return nil
+	})
+}
+
 func (r *transactionRepositoryImpl) GetByDateRange(ctx context.Context, userID uint, startDate, endDate time.Time) ([]*entities.Transaction, error) {
 	var models []models.Transaction
 
 	if err := r.db.WithContext(ctx).
+		Preload("Splits").
+		Preload("TagLinks").
 		Where("user_id = ? AND date BETWEEN ? AND ?", userID, startDate, endDate).
 		Order("date DESC").
 		Find(&models).Error; err != nil {
@@ -135,11 +663,31 @@ func (r *transactionRepositoryImpl) GetByDateRange(ctx context.Context, userID u
 	return transactions, nil
 }
 
+func (r *transactionRepositoryImpl) GetUnpaidExpenses(ctx context.Context, startDate, endDate time.Time) ([]*entities.Transaction, error) {
+	var models []models.Transaction
+
+	if err := r.db.WithContext(ctx).
+		Where("type = ? AND paid = ? AND date >= ? AND date < ?", entities.EXPENSE, false, startDate, endDate).
+		Order("user_id, date").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entities.Transaction, len(models))
+	for i, model := range models {
+		transactions[i] = model.ToEntity()
+	}
+
+	return transactions, nil
+}
+
 func (r *transactionRepositoryImpl) GetRecurringTransactions(ctx context.Context, userID uint) ([]*entities.Transaction, error) {
 	var models []models.Transaction
 
 	if err := r.db.WithContext(ctx).
-		Where("user_id = ? AND is_recurrent = ?", userID, true).
+		Preload("Splits").
+		Preload("TagLinks").
+		Where("user_id = ? AND is_recurrent = ? AND parent_id IS NULL", userID, true).
 		Find(&models).Error; err != nil {
 		return nil, err
 	}
@@ -152,10 +700,250 @@ func (r *transactionRepositoryImpl) GetRecurringTransactions(ctx context.Context
 	return transactions, nil
 }
 
+func (r *transactionRepositoryImpl) GetAllRecurringTransactions(ctx context.Context) ([]*entities.Transaction, error) {
+	var models []models.Transaction
+
+	if err := r.db.WithContext(ctx).
+		Preload("Splits").
+		Preload("TagLinks").
+		Where("is_recurrent = ? AND parent_id IS NULL", true).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entities.Transaction, len(models))
+	for i, model := range models {
+		transactions[i] = model.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+func (r *transactionRepositoryImpl) GetOccurrenceDates(ctx context.Context, parentID uint) ([]time.Time, error) {
+	var dates []time.Time
+
+	// Unscoped para considerar também ocorrências excluídas pelo usuário
+	if err := r.db.WithContext(ctx).Unscoped().Model(&models.Transaction{}).
+		Where("parent_id = ?", parentID).
+		Pluck("date", &dates).Error; err != nil {
+		return nil, err
+	}
+
+	return dates, nil
+}
+
+func (r *transactionRepositoryImpl) CreateOccurrences(ctx context.Context, template *entities.Transaction, occurrences []*entities.Transaction) (int, error) {
+	created := 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &transactionRepositoryImpl{db: tx}
+
+		if template.ID == 0 {
+			if err := txRepo.Create(ctx, template); err != nil {
+				return err
+			}
+		} else if err := lockTransaction(tx, template.ID); err != nil {
+			return err
+		}
+
+		var err error
+		created, err = txRepo.createMissingOccurrences(ctx, template.ID, occurrences)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return created, nil
+}
+
+func (r *transactionRepositoryImpl) UpdateRecurrence(ctx context.Context, previous, template *entities.Transaction, from time.Time, occurrences []*entities.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &transactionRepositoryImpl{db: tx}
+
+		if err := lockTransaction(tx, template.ID); err != nil {
+			return err
+		}
+		if err := txRepo.Update(ctx, template); err != nil {
+			return err
+		}
+
+		var pending []models.Transaction
+		if err := tx.Preload("Splits").Preload("TagLinks").
+			Where("parent_id = ? AND paid = ? AND date >= ?", template.ID, false, from).
+			Order("date ASC").
+			Find(&pending).Error; err != nil {
+			return err
+		}
+
+		existing := make([]*entities.Transaction, len(pending))
+		ids := make([]uint, len(pending))
+		for i, model := range pending {
+			existing[i] = model.ToEntity()
+			ids[i] = model.ID
+		}
+
+		attached := make(map[uint]bool)
+		if len(ids) > 0 {
+			var attachedIDs []uint
+			if err := tx.Model(&models.Attachment{}).
+				Where("transaction_id IN ?", ids).
+				Distinct().
+				Pluck("transaction_id", &attachedIDs).Error; err != nil {
+				return err
+			}
+			for _, id := range attachedIDs {
+				attached[id] = true
+			}
+		}
+
+		updates, deleteIDs, creates := entities.OccurrenceChanges(previous, existing, occurrences, attached)
+
+		for _, occurrence := range updates {
+			if err := txRepo.Update(ctx, occurrence); err != nil {
+				return err
+			}
+		}
+
+		// Exclusão lógica: a data fica registrada e não é gerada de novo
+		if len(deleteIDs) > 0 {
+			if err := tx.Where("id IN ? AND parent_id = ?", deleteIDs, template.ID).
+				Delete(&models.Transaction{}).Error; err != nil {
+				return err
+			}
+		}
+
+		_, err := txRepo.createMissingOccurrences(ctx, template.ID, creates)
+		return err
+	})
+}
+
+// lockTransaction bloqueia a linha da transação até o fim de tx
+func lockTransaction(tx *gorm.DB, id uint) error {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&models.Transaction{}, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgErrors.ErrTransactionNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// createMissingOccurrences vincula as ocorrências ao modelo e grava as que têm data
+// ainda não gerada
+func (r *transactionRepositoryImpl) createMissingOccurrences(ctx context.Context, parentID uint, occurrences []*entities.Transaction) (int, error) {
+	dates, err := r.GetOccurrenceDates(ctx, parentID)
+	if err != nil {
+		return 0, err
+	}
+
+	existing := make(map[string]bool, len(dates))
+	for _, date := range dates {
+		existing[date.Format("2006-01-02")] = true
+	}
+
+	created := 0
+	for _, occurrence := range occurrences {
+		key := occurrence.Date.Format("2006-01-02")
+		if existing[key] {
+			continue
+		}
+
+		occurrence.ParentID = &parentID
+		if err := r.Create(ctx, occurrence); err != nil {
+			return 0, err
+		}
+		existing[key] = true
+		created++
+	}
+
+	return created, nil
+}
+
+func (r *transactionRepositoryImpl) CreateInstallments(ctx context.Context, installments []*entities.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &transactionRepositoryImpl{db: tx}
+		for i, installment := range installments {
+			if i > 0 {
+				parentID := installments[0].ID
+				installment.ParentID = &parentID
+			}
+			if err := txRepo.Create(ctx, installment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *transactionRepositoryImpl) GetInstallments(ctx context.Context, groupID uint) ([]*entities.Transaction, error) {
+	var models []models.Transaction
+
+	if err := r.db.WithContext(ctx).
+		Preload("Splits").
+		Preload("TagLinks").
+		Where("(id = ? OR parent_id = ?) AND installment_total > 1", groupID, groupID).
+		Order("installment_number ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entities.Transaction, len(models))
+	for i, model := range models {
+		transactions[i] = model.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+func (r *transactionRepositoryImpl) GetByInvoiceID(ctx context.Context, invoiceID uint) ([]*entities.Transaction, error) {
+	var models []models.Transaction
+
+	if err := r.db.WithContext(ctx).
+		Preload("Splits").
+		Preload("TagLinks").
+		Where("invoice_id = ?", invoiceID).
+		Order("date ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entities.Transaction, len(models))
+	for i, model := range models {
+		transactions[i] = model.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+func (r *transactionRepositoryImpl) GetExistingExternalIDs(ctx context.Context, userID uint, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	var found []string
+
+	// Unscoped para que linhas excluídas pelo usuário não sejam importadas novamente
+	if err := r.db.WithContext(ctx).Unscoped().Model(&models.Transaction{}).
+		Where("user_id = ? AND external_id IN ?", userID, externalIDs).
+		Pluck("external_id", &found).Error; err != nil {
+		return nil, err
+	}
+
+	for _, id := range found {
+		existing[id] = true
+	}
+
+	return existing, nil
+}
+
 func (r *transactionRepositoryImpl) GetInvestmentsByPiggyBank(ctx context.Context, piggyBankID uint) ([]*entities.Transaction, error) {
 	var models []models.Transaction
 
 	if err := r.db.WithContext(ctx).
+		Preload("Splits").
+		Preload("TagLinks").
 		Where("piggy_bank_id = ? AND type = ?", piggyBankID, entities.INVESTMENT).
 		Find(&models).Error; err != nil {
 		return nil, err
@@ -192,10 +980,10 @@ func (r *transactionRepositoryImpl) GetTotalAmountByType(ctx context.Context, us
 func (r *transactionRepositoryImpl) GetTotalAmountByCategory(ctx context.Context, userID uint, categoryID uint) (float64, error) {
 	var total float64
 
-	if err := r.db.WithContext(ctx).Model(&models.Transaction{}).
-		Where("user_id = ? AND category_id = ? AND paid = ?", userID, categoryID, true).
-		Select("COALESCE(SUM(amount), 0)").
-		Scan(&total).Error; err != nil {
+	query := `SELECT COALESCE(SUM(l.amount), 0) FROM ` + categoryLinesSQL + ` l
+		WHERE l.user_id = ? AND l.category_id = ? AND l.paid = ?`
+
+	if err := r.db.WithContext(ctx).Raw(query, userID, categoryID, true).Scan(&total).Error; err != nil {
 		return 0, err
 	}
 
@@ -288,16 +1076,17 @@ func (r *transactionRepositoryImpl) GetCategoryTotals(ctx context.Context, userI
 	// Construir query base
 	query := `
 		SELECT 
+			c.id AS category_id,
 			c.name AS category_name,
 			SUM(t.amount) AS total,
 			t.type AS type
 		FROM 
-			transactions t
+			` + categoryLinesSQL + ` t
 		JOIN 
 			categories c ON t.category_id = c.id
 		WHERE 
 			t.user_id = ?
-			AND t.deleted_at IS NULL
+			AND t.type <> 'transfer'
 	`
 
 	// Parâmetros para a query
@@ -327,6 +1116,7 @@ func (r *transactionRepositoryImpl) GetCategoryTotals(ctx context.Context, userI
 	// Agrupar por categoria e tipo
 	query += `
 		GROUP BY 
+			c.id,
 			c.name, 
 			t.type
 		ORDER BY 
@@ -354,3 +1144,173 @@ func (r *transactionRepositoryImpl) GetCategoryTotals(ctx context.Context, userI
 
 	return categoryTotals, nil
 }
+
+func (r *transactionRepositoryImpl) GetCategoryMonthlyTotals(ctx context.Context, userID uint, filters *repositories.TransactionFilters) ([]repositories.CategoryMonthTotal, error) {
+	var totals []repositories.CategoryMonthTotal
+
+	// O mês é calculado em UTC, como os limites de mês usados nos filtros
+	query := `SELECT t.category_id, TO_CHAR(t.date AT TIME ZONE 'UTC', 'YYYY-MM') AS month, SUM(t.amount) AS total
+		FROM ` + categoryLinesSQL + ` t
+		WHERE t.user_id = ? AND t.category_id IS NOT NULL AND t.type <> 'transfer'`
+	params := []interface{}{userID}
+
+	if filters != nil {
+		if !filters.StartDate.IsZero() {
+			query += " AND t.date >= ?"
+			params = append(params, filters.StartDate)
+		}
+		if !filters.EndDate.IsZero() {
+			query += " AND t.date <= ?"
+			params = append(params, filters.EndDate)
+		}
+		if filters.Type != nil {
+			query += " AND t.type = ?"
+			params = append(params, *filters.Type)
+		}
+	}
+
+	query += " GROUP BY t.category_id, month ORDER BY month"
+
+	if err := r.db.WithContext(ctx).Raw(query, params...).Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (r *transactionRepositoryImpl) GetMonthlyTypeTotals(ctx context.Context, userID uint, startDate, endDate time.Time) ([]repositories.MonthTypeTotal, error) {
+	var totals []repositories.MonthTypeTotal
+
+	// O mês é calculado em UTC, como em GetCategoryMonthlyTotals
+	if err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Select("TO_CHAR(date AT TIME ZONE 'UTC', 'YYYY-MM') AS month, type, SUM(amount) AS total").
+		Where("user_id = ? AND type <> ? AND date >= ? AND date <= ?", userID, entities.TRANSFER, startDate, endDate).
+		Group("month, type").
+		Order("month").
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (r *transactionRepositoryImpl) GetTagTotals(ctx context.Context, userID uint, filters *repositories.TransactionFilters) ([]repositories.TagTotal, error) {
+	var tagTotals []repositories.TagTotal
+
+	// Transações com várias tags entram no total de cada uma delas
+	query := `
+		SELECT
+			tg.name AS tag_name,
+			SUM(t.amount) AS total,
+			t.type AS type
+		FROM
+			transactions t
+		JOIN
+			transaction_tags tt ON tt.transaction_id = t.id
+		JOIN
+			tags tg ON tg.id = tt.tag_id
+		WHERE
+			t.user_id = ?
+			AND t.deleted_at IS NULL
+			AND t.type <> 'transfer'
+	`
+
+	params := []interface{}{userID}
+
+	if filters != nil {
+		if !filters.StartDate.IsZero() {
+			query += " AND t.date >= ?"
+			params = append(params, filters.StartDate)
+		}
+		if !filters.EndDate.IsZero() {
+			query += " AND t.date <= ?"
+			params = append(params, filters.EndDate)
+		}
+		if filters.Type != nil {
+			query += " AND t.type = ?"
+			params = append(params, *filters.Type)
+		}
+	}
+
+	query += `
+		GROUP BY
+			tg.name,
+			t.type
+		ORDER BY
+			total DESC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, params...).Scan(&tagTotals).Error; err != nil {
+		log.Printf("Erro ao buscar totais por tag: %v", err)
+		return nil, err
+	}
+
+	return tagTotals, nil
+}
+
+// uniqueIDs remove IDs repetidos mantendo a ordem original
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func (r *transactionRepositoryImpl) GetPayeeTotals(ctx context.Context, userID uint, filters *repositories.TransactionFilters) ([]repositories.PayeeTotal, error) {
+	var payeeTotals []repositories.PayeeTotal
+
+	query := `
+		SELECT
+			p.id AS payee_id,
+			p.name AS payee_name,
+			SUM(t.amount) AS total,
+			COUNT(*) AS count,
+			t.type AS type
+		FROM
+			transactions t
+		JOIN
+			payees p ON p.id = t.payee_id
+		WHERE
+			t.user_id = ?
+			AND t.deleted_at IS NULL
+			AND t.type <> 'transfer'
+	`
+
+	params := []interface{}{userID}
+
+	if filters != nil {
+		if !filters.StartDate.IsZero() {
+			query += " AND t.date >= ?"
+			params = append(params, filters.StartDate)
+		}
+		if !filters.EndDate.IsZero() {
+			query += " AND t.date <= ?"
+			params = append(params, filters.EndDate)
+		}
+		if filters.Type != nil {
+			query += " AND t.type = ?"
+			params = append(params, *filters.Type)
+		}
+	}
+
+	query += `
+		GROUP BY
+			p.id,
+			p.name,
+			t.type
+		ORDER BY
+			total DESC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, params...).Scan(&payeeTotals).Error; err != nil {
+		log.Printf("Erro ao buscar totais por favorecido: %v", err)
+		return nil, err
+	}
+
+	return payeeTotals, nil
+}
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountController struct {
+	accountService interfaces.AccountService
+}
+
+func NewAccountController(accountService interfaces.AccountService) *AccountController {
+	return &AccountController{
+		accountService: accountService,
+	}
+}
+
+func (c *AccountController) CreateAccount(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.CreateAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountEntity := req.ToEntity(userID)
+	account, err := c.accountService.CreateAccount(ctx.Request.Context(), userID, accountEntity)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToAccountResponse(account)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *AccountController) GetAccounts(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var filters dto.AccountFiltersRequest
+	if err := ctx.ShouldBindQuery(&filters); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accounts, err := c.accountService.GetAccountsByUser(ctx.Request.Context(), userID, filters.IncludeArchived)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToAccountResponseList(accounts)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *AccountController) GetAccount(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	accountID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	account, err := c.accountService.GetAccountByID(ctx.Request.Context(), userID, uint(accountID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToAccountResponse(account)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *AccountController) UpdateAccount(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	accountID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.UpdateAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updates := req.ToEntity(userID)
+	account, err := c.accountService.UpdateAccount(ctx.Request.Context(), userID, uint(accountID), updates)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToAccountResponse(account)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *AccountController) DeleteAccount(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	accountID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	err = c.accountService.DeleteAccount(ctx.Request.Context(), userID, uint(accountID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *AccountController) ArchiveAccount(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	accountID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.ArchiveAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	account, err := c.accountService.SetArchived(ctx.Request.Context(), userID, uint(accountID), req.Archived)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToAccountResponse(account)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *AccountController) GetBalances(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	balances, err := c.accountService.GetAccountBalances(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, balances)
+}
+
+func (c *AccountController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
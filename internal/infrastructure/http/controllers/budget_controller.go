@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BudgetController struct {
+	budgetService interfaces.BudgetService
+}
+
+func NewBudgetController(budgetService interfaces.BudgetService) *BudgetController {
+	return &BudgetController{
+		budgetService: budgetService,
+	}
+}
+
+func (c *BudgetController) CreateBudget(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.BudgetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	budget, err := c.budgetService.CreateBudget(ctx.Request.Context(), userID, req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToBudgetResponse(budget)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *BudgetController) GetBudgets(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	budgets, err := c.budgetService.GetBudgetsByUser(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToBudgetResponseList(budgets)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *BudgetController) GetBudgetStatus(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.BudgetStatusRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	year, month := req.Period()
+	statuses, err := c.budgetService.GetBudgetStatus(ctx.Request.Context(), userID, year, month)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToBudgetReportResponse(year, month, statuses)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *BudgetController) GetBudget(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	budgetID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	budget, err := c.budgetService.GetBudgetByID(ctx.Request.Context(), userID, uint(budgetID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToBudgetResponse(budget)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *BudgetController) UpdateBudget(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	budgetID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.UpdateBudgetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	budget, err := c.budgetService.UpdateBudget(ctx.Request.Context(), userID, uint(budgetID), req.ToEntity())
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToBudgetResponse(budget)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *BudgetController) DeleteBudget(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	budgetID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	err = c.budgetService.DeleteBudget(ctx.Request.Context(), userID, uint(budgetID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *BudgetController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
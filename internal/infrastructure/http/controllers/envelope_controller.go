@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"net/http"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EnvelopeController struct {
+	envelopeService interfaces.EnvelopeService
+}
+
+func NewEnvelopeController(envelopeService interfaces.EnvelopeService) *EnvelopeController {
+	return &EnvelopeController{
+		envelopeService: envelopeService,
+	}
+}
+
+func (c *EnvelopeController) EnableEnvelopes(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	// O corpo é opcional; sem ele os envelopes começam no mês atual
+	var req dto.EnableEnvelopesRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	startMonth, err := req.StartMonthValue()
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	settings, err := c.envelopeService.EnableEnvelopes(ctx.Request.Context(), userID, startMonth)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToEnvelopeSettingsResponse(settings)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *EnvelopeController) GetEnvelopeSettings(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	settings, err := c.envelopeService.GetEnvelopeSettings(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToEnvelopeSettingsResponse(settings)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *EnvelopeController) DisableEnvelopes(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	if err := c.envelopeService.DisableEnvelopes(ctx.Request.Context(), userID); err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *EnvelopeController) GetEnvelopeMonth(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	month, err := dto.ParseMonth(ctx.Param("month"))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	envelopeMonth, err := c.envelopeService.GetEnvelopeMonth(ctx.Request.Context(), userID, month)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToEnvelopeMonthResponse(envelopeMonth)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *EnvelopeController) AssignToEnvelope(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.AssignEnvelopeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	month, err := req.MonthValue()
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	envelopeMonth, err := c.envelopeService.AssignToEnvelope(ctx.Request.Context(), userID, month, req.CategoryID, req.Amount, req.Note)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToEnvelopeMonthResponse(envelopeMonth)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *EnvelopeController) MoveBetweenEnvelopes(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.MoveEnvelopeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	month, err := req.MonthValue()
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	envelopeMonth, err := c.envelopeService.MoveBetweenEnvelopes(ctx.Request.Context(), userID, month,
+		req.FromCategoryID, req.ToCategoryID, req.Amount, req.Note)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToEnvelopeMonthResponse(envelopeMonth)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *EnvelopeController) GetLedger(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.EnvelopeLedgerRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entries, err := c.envelopeService.GetLedger(ctx.Request.Context(), userID, req.ToRepositoryFilters())
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToEnvelopeEntryResponseList(entries)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *EnvelopeController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
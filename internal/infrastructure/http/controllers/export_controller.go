@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"time"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportController struct {
+	exportService interfaces.ExportService
+}
+
+func NewExportController(exportService interfaces.ExportService) *ExportController {
+	return &ExportController{
+		exportService: exportService,
+	}
+}
+
+// ExportTransactions gera o arquivo (format=csv, xlsx ou ofx; padrão csv) com as
+// transações que atendem aos mesmos filtros da listagem
+func (c *ExportController) ExportTransactions(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.ExportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	format := entities.ExportFormat(req.Format)
+	if format == "" {
+		format = entities.EXPORT_CSV
+	}
+
+	fileName := fmt.Sprintf("transacoes-%s.%s", time.Now().Format("20060102"), format)
+	ctx.Header("Content-Type", format.ContentType())
+	ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	ctx.Status(http.StatusOK)
+
+	err := c.exportService.ExportTransactions(ctx.Request.Context(), userID, req.ToRepositoryFilters(userID), format, ctx.Writer)
+	if err == nil {
+		return
+	}
+
+	// O arquivo é enviado em partes; depois do primeiro envio não há como responder com erro
+	if ctx.Writer.Written() {
+		log.Printf("Erro ao exportar transações do usuário %d: %v", userID, err)
+		ctx.Abort()
+		return
+	}
+
+	ctx.Writer.Header().Del("Content-Type")
+	ctx.Writer.Header().Del("Content-Disposition")
+	c.handleError(ctx, err)
+}
+
+func (c *ExportController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
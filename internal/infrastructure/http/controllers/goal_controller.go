@@ -117,6 +117,38 @@ func (c *GoalController) DeleteGoal(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+func (c *GoalController) GetGoalsProgress(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	progress, err := c.goalService.GetGoalsProgress(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToGoalProgressResponseList(progress)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *GoalController) GetGoalProgress(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	goalID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	progress, err := c.goalService.GetGoalProgress(ctx.Request.Context(), userID, uint(goalID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToGoalProgressResponse(progress)
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *GoalController) handleError(ctx *gin.Context, err error) {
 	if domainErr, ok := err.(pkgErrors.DomainError); ok {
 		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
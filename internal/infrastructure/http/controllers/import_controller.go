@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ImportController struct {
+	importService interfaces.ImportService
+}
+
+func NewImportController(importService interfaces.ImportService) *ImportController {
+	return &ImportController{
+		importService: importService,
+	}
+}
+
+// ImportOFX recebe o extrato (multipart, campo "file"). Sem commit=true retorna
+// apenas a pré-visualização das linhas.
+func (c *ImportController) ImportOFX(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.ImportRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, ok := c.openUploadedFile(ctx)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	result, err := c.importService.ImportOFX(ctx.Request.Context(), userID, req.AccountID, file, req.Commit)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	status := http.StatusOK
+	if result.Committed {
+		status = http.StatusCreated
+	}
+
+	response := dto.ToImportResultResponse(result)
+	ctx.JSON(status, response)
+}
+
+// ImportCSV recebe o CSV (multipart, campo "file") e o perfil com o mapeamento das
+// colunas. Linhas inválidas voltam com o erro no item correspondente.
+func (c *ImportController) ImportCSV(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.ImportCSVRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, ok := c.openUploadedFile(ctx)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	result, err := c.importService.ImportCSV(ctx.Request.Context(), userID, req.ProfileID, req.AccountID, file, req.Commit)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	status := http.StatusOK
+	if result.Committed {
+		status = http.StatusCreated
+	}
+
+	response := dto.ToImportResultResponse(result)
+	ctx.JSON(status, response)
+}
+
+func (c *ImportController) CreateProfile(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.ImportProfileRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	profile, err := c.importService.CreateProfile(ctx.Request.Context(), userID, req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToImportProfileResponse(profile)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *ImportController) GetProfiles(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	profiles, err := c.importService.GetProfilesByUser(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToImportProfileResponseList(profiles)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *ImportController) GetProfile(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	profileID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	profile, err := c.importService.GetProfileByID(ctx.Request.Context(), userID, uint(profileID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToImportProfileResponse(profile)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *ImportController) UpdateProfile(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	profileID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.ImportProfileRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	profile, err := c.importService.UpdateProfile(ctx.Request.Context(), userID, uint(profileID), req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToImportProfileResponse(profile)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *ImportController) DeleteProfile(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	profileID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	err = c.importService.DeleteProfile(ctx.Request.Context(), userID, uint(profileID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// openUploadedFile abre o arquivo do campo "file", respondendo 400 quando ausente
+func (c *ImportController) openUploadedFile(ctx *gin.Context) (multipart.File, bool) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo não enviado no campo 'file'"})
+		return nil, false
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Não foi possível ler o arquivo enviado"})
+		return nil, false
+	}
+
+	return file, true
+}
+
+func (c *ImportController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
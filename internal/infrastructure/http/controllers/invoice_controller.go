@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InvoiceController struct {
+	invoiceService interfaces.InvoiceService
+}
+
+func NewInvoiceController(invoiceService interfaces.InvoiceService) *InvoiceController {
+	return &InvoiceController{
+		invoiceService: invoiceService,
+	}
+}
+
+func (c *InvoiceController) GetAccountInvoices(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	accountID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var filters dto.InvoiceFiltersRequest
+	if err := ctx.ShouldBindQuery(&filters); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	invoices, err := c.invoiceService.GetInvoicesByAccount(ctx.Request.Context(), userID, uint(accountID), filters.Status)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToInvoiceResponseList(invoices)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *InvoiceController) GetInvoice(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	invoiceID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	invoice, err := c.invoiceService.GetInvoiceByID(ctx.Request.Context(), userID, uint(invoiceID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	items, err := c.invoiceService.GetInvoiceItems(ctx.Request.Context(), userID, uint(invoiceID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToInvoiceDetailResponse(invoice, items)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *InvoiceController) PayInvoice(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	invoiceID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.PayInvoiceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	invoice, err := c.invoiceService.PayInvoice(ctx.Request.Context(), userID, uint(invoiceID), req.FromAccountID, req.Date)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToInvoiceResponse(invoice)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *InvoiceController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JobController struct {
+	schedulerService interfaces.SchedulerService
+}
+
+func NewJobController(schedulerService interfaces.SchedulerService) *JobController {
+	return &JobController{
+		schedulerService: schedulerService,
+	}
+}
+
+func (c *JobController) GetJobs(ctx *gin.Context) {
+	jobs, err := c.schedulerService.ListJobs(ctx.Request.Context())
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToScheduledJobResponseList(jobs)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *JobController) GetJobRuns(ctx *gin.Context) {
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Limite inválido"})
+		return
+	}
+
+	runs, err := c.schedulerService.GetJobRuns(ctx.Request.Context(), ctx.Param("name"), limit)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToJobRunResponseList(runs)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *JobController) TriggerJob(ctx *gin.Context) {
+	run, err := c.schedulerService.TriggerJob(ctx.Request.Context(), ctx.Param("name"))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToJobRunResponse(run)
+	ctx.JSON(http.StatusAccepted, response)
+}
+
+func (c *JobController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController struct {
+	notificationService interfaces.NotificationService
+}
+
+func NewNotificationController(notificationService interfaces.NotificationService) *NotificationController {
+	return &NotificationController{
+		notificationService: notificationService,
+	}
+}
+
+func (c *NotificationController) GetNotifications(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.NotificationListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	notifications, unread, err := c.notificationService.GetNotifications(ctx.Request.Context(), userID, req.Unread, req.Limit)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToNotificationListResponse(notifications, unread)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *NotificationController) MarkAsRead(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	notificationID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	notification, err := c.notificationService.MarkAsRead(ctx.Request.Context(), userID, uint(notificationID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToNotificationResponse(notification)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *NotificationController) MarkAllAsRead(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	updated, err := c.notificationService.MarkAllAsRead(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"updated": updated})
+}
+
+func (c *NotificationController) GetSettings(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	settings, err := c.notificationService.GetSettings(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToNotificationSettingsResponse(settings)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *NotificationController) UpdateSettings(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.NotificationSettingsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, err := c.notificationService.UpdateSettings(ctx.Request.Context(), userID, req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToNotificationSettingsResponse(settings)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *NotificationController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PayeeController struct {
+	payeeService interfaces.PayeeService
+}
+
+func NewPayeeController(payeeService interfaces.PayeeService) *PayeeController {
+	return &PayeeController{
+		payeeService: payeeService,
+	}
+}
+
+func (c *PayeeController) CreatePayee(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.PayeeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	payee, err := c.payeeService.CreatePayee(ctx.Request.Context(), userID, req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToPayeeResponse(payee)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *PayeeController) GetPayees(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	payees, err := c.payeeService.GetPayeesByUser(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToPayeeResponseList(payees)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *PayeeController) GetPayee(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	payeeID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	payee, err := c.payeeService.GetPayeeByID(ctx.Request.Context(), userID, uint(payeeID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToPayeeResponse(payee)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *PayeeController) UpdatePayee(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	payeeID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.PayeeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	payee, err := c.payeeService.UpdatePayee(ctx.Request.Context(), userID, uint(payeeID), req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToPayeeResponse(payee)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *PayeeController) DeletePayee(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	payeeID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	err = c.payeeService.DeletePayee(ctx.Request.Context(), userID, uint(payeeID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *PayeeController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
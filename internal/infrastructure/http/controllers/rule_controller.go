@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RuleController struct {
+	ruleService interfaces.RuleService
+}
+
+func NewRuleController(ruleService interfaces.RuleService) *RuleController {
+	return &RuleController{
+		ruleService: ruleService,
+	}
+}
+
+func (c *RuleController) CreateRule(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.RuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := c.ruleService.CreateRule(ctx.Request.Context(), userID, req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToRuleResponse(rule)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *RuleController) GetRules(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	rules, err := c.ruleService.GetRulesByUser(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToRuleResponseList(rules)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *RuleController) GetRule(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	ruleID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	rule, err := c.ruleService.GetRuleByID(ctx.Request.Context(), userID, uint(ruleID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToRuleResponse(rule)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *RuleController) UpdateRule(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	ruleID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.RuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := c.ruleService.UpdateRule(ctx.Request.Context(), userID, uint(ruleID), req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToRuleResponse(rule)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *RuleController) DeleteRule(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	ruleID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	err = c.ruleService.DeleteRule(ctx.Request.Context(), userID, uint(ruleID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// PreviewRule lista as transações existentes que a regra alteraria, sem gravá-las
+func (c *RuleController) PreviewRule(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	ruleID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.RuleRunRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	changes, err := c.ruleService.PreviewRule(ctx.Request.Context(), userID, uint(ruleID), req.Overwrite)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToRuleRunResponse(changes, false)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ApplyRule aplica a regra retroativamente às transações existentes
+func (c *RuleController) ApplyRule(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	ruleID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	// O corpo é opcional; sem ele as categorias existentes são mantidas
+	var req dto.RuleRunRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	changes, err := c.ruleService.ApplyRule(ctx.Request.Context(), userID, uint(ruleID), req.Overwrite)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToRuleRunResponse(changes, true)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *RuleController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
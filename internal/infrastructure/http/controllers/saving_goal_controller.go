@@ -126,13 +126,13 @@ func (c *SavingGoalController) Deposit(ctx *gin.Context) {
 		return
 	}
 
-	var req dto.DepositRequest
+	var req dto.SavingGoalMovementRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	savingGoal, err := c.savingGoalService.Deposit(ctx.Request.Context(), userID, uint(savingGoalID), req.Amount)
+	savingGoal, err := c.savingGoalService.Deposit(ctx.Request.Context(), userID, uint(savingGoalID), req.Amount, req.MovementDate(), req.Note)
 	if err != nil {
 		c.handleError(ctx, err)
 		return
@@ -142,6 +142,69 @@ func (c *SavingGoalController) Deposit(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+func (c *SavingGoalController) Withdraw(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	savingGoalID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.SavingGoalMovementRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	savingGoal, err := c.savingGoalService.Withdraw(ctx.Request.Context(), userID, uint(savingGoalID), req.Amount, req.MovementDate(), req.Note)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToSavingGoalResponse(savingGoal)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *SavingGoalController) GetMovements(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	savingGoalID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	movements, err := c.savingGoalService.GetMovements(ctx.Request.Context(), userID, uint(savingGoalID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToSavingGoalMovementResponseList(movements)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *SavingGoalController) GetHistory(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	savingGoalID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	history, err := c.savingGoalService.GetHistory(ctx.Request.Context(), userID, uint(savingGoalID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToSavingGoalHistoryResponseList(history)
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *SavingGoalController) handleError(ctx *gin.Context, err error) {
 	if domainErr, ok := err.(pkgErrors.DomainError); ok {
 		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
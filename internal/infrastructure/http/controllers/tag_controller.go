@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/infrastructure/http/dto"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagController struct {
+	tagService interfaces.TagService
+}
+
+func NewTagController(tagService interfaces.TagService) *TagController {
+	return &TagController{
+		tagService: tagService,
+	}
+}
+
+// CreateTag godoc
+// @Summary Criar nova tag
+// @Description Cria uma nova tag para o usuário autenticado
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body dto.CreateTagRequest true "Dados da tag"
+// @Success 201 {object} dto.TagResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /tags [post]
+func (c *TagController) CreateTag(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.CreateTagRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tagEntity := req.ToEntity(userID)
+	tag, err := c.tagService.CreateTag(ctx.Request.Context(), userID, tagEntity)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTagResponse(tag)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// GetTags godoc
+// @Summary Listar tags
+// @Description Lista todas as tags do usuário autenticado
+// @Tags tags
+// @Produce json
+// @Success 200 {array} dto.TagResponse
+// @Failure 401 {object} map[string]interface{}
+// @Router /tags [get]
+func (c *TagController) GetTags(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	tags, err := c.tagService.GetTagsByUser(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTagResponseList(tags)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetTag godoc
+// @Summary Obter tag por ID
+// @Description Obtém uma tag específica pelo ID
+// @Tags tags
+// @Produce json
+// @Param id path int true "ID da tag"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /tags/{id} [get]
+func (c *TagController) GetTag(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	tagID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	tag, err := c.tagService.GetTagByID(ctx.Request.Context(), userID, uint(tagID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTagResponse(tag)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateTag godoc
+// @Summary Atualizar tag
+// @Description Atualiza uma tag existente
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da tag"
+// @Param tag body dto.UpdateTagRequest true "Dados atualizados da tag"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /tags/{id} [put]
+func (c *TagController) UpdateTag(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	tagID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.UpdateTagRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updates := req.ToEntity(userID)
+	tag, err := c.tagService.UpdateTag(ctx.Request.Context(), userID, uint(tagID), updates)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTagResponse(tag)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// DeleteTag godoc
+// @Summary Excluir tag
+// @Description Exclui uma tag existente
+// @Tags tags
+// @Param id path int true "ID da tag"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /tags/{id} [delete]
+func (c *TagController) DeleteTag(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	tagID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	err = c.tagService.DeleteTag(ctx.Request.Context(), userID, uint(tagID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *TagController) handleError(ctx *gin.Context, err error) {
+	if domainErr, ok := err.(pkgErrors.DomainError); ok {
+		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Erro interno do servidor"})
+}
@@ -1,11 +1,14 @@
 package controllers
 
 import (
+	"log"
+	"mime"
 	"net/http"
 	"strconv"
 	"time"
 
 	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
 	"my-finance-hub-api/internal/domain/repositories"
 	"my-finance-hub-api/internal/infrastructure/http/dto"
 	pkgErrors "my-finance-hub-api/pkg/errors"
@@ -15,11 +18,15 @@ import (
 
 type TransactionController struct {
 	transactionService interfaces.TransactionService
+	attachmentService  interfaces.AttachmentService
+	suggestionService  interfaces.CategorySuggestionService
 }
 
-func NewTransactionController(transactionService interfaces.TransactionService) *TransactionController {
+func NewTransactionController(transactionService interfaces.TransactionService, attachmentService interfaces.AttachmentService, suggestionService interfaces.CategorySuggestionService) *TransactionController {
 	return &TransactionController{
 		transactionService: transactionService,
+		attachmentService:  attachmentService,
+		suggestionService:  suggestionService,
 	}
 }
 
@@ -33,6 +40,30 @@ func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
 	}
 
 	transactionEntity := req.ToEntity(userID)
+
+	if req.IsInstallmentPurchase() {
+		installments, err := c.transactionService.CreateInstallmentPurchase(ctx.Request.Context(), userID, transactionEntity,
+			req.Installments, req.InterestRate, req.InstallmentRemainder == "last")
+		if err != nil {
+			c.handleError(ctx, err)
+			return
+		}
+
+		response := dto.ToTransactionResponseList(installments)
+
+		candidates, err := c.transactionService.FindDuplicateCandidates(ctx.Request.Context(), userID, installments)
+		if err != nil {
+			log.Printf("Erro ao verificar duplicatas da compra parcelada %d: %v", installments[0].ID, err)
+		} else {
+			for i := range response {
+				response[i].SetDuplicateCandidates(candidates[i])
+			}
+		}
+
+		ctx.JSON(http.StatusCreated, response)
+		return
+	}
+
 	transaction, err := c.transactionService.CreateTransaction(ctx.Request.Context(), userID, transactionEntity)
 	if err != nil {
 		c.handleError(ctx, err)
@@ -40,39 +71,135 @@ func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
 	}
 
 	response := dto.ToTransactionResponse(transaction)
+
+	// A transação já foi criada; uma falha na verificação apenas omite o aviso
+	candidates, err := c.transactionService.FindDuplicateCandidates(ctx.Request.Context(), userID, []*entities.Transaction{transaction})
+	if err != nil {
+		log.Printf("Erro ao verificar duplicatas da transação %d: %v", transaction.ID, err)
+	} else {
+		response.SetDuplicateCandidates(candidates[0])
+	}
+
 	ctx.JSON(http.StatusCreated, response)
 }
 
-func (c *TransactionController) GetTransactions(ctx *gin.Context) {
+func (c *TransactionController) GetDuplicates(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	groups, err := c.transactionService.GetDuplicateGroups(ctx.Request.Context(), userID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToDuplicateGroupResponseList(groups)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *TransactionController) MergeDuplicates(ctx *gin.Context) {
 	userID := ctx.GetUint("user_id")
 
-	// Parse filters from query parameters
-	var filters dto.TransactionFiltersRequest
-	if err := ctx.ShouldBindQuery(&filters); err != nil {
+	var req dto.MergeDuplicatesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	// Convert DTO filters to repository filters
-	repoFilters := &repositories.TransactionFilters{
-		UserID:     userID,
-		Paid:       filters.Paid,
-		Month:      filters.Month,
-		Year:       filters.Year,
-		Type:       filters.Type,
-		CategoryID: filters.CategoryID,
+	transaction, err := c.transactionService.MergeDuplicates(ctx.Request.Context(), userID, req.KeepID, req.DuplicateIDs)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTransactionResponse(transaction)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *TransactionController) BulkUpdateTransactions(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.BulkTransactionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := c.transactionService.BulkUpdateTransactions(ctx.Request.Context(), userID, req.IDs, req.ToEntity())
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToBulkTransactionResponse(results)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *TransactionController) SuggestCategory(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.SuggestCategoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	suggestions, err := c.suggestionService.SuggestCategory(ctx.Request.Context(), userID, req.Description, req.Limit)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToCategorySuggestionResponseList(suggestions)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *TransactionController) SearchTransactions(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.SearchTransactionsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
-	transactions, err := c.transactionService.GetTransactionsByUser(ctx.Request.Context(), userID, repoFilters)
+	hits, err := c.transactionService.SearchTransactions(ctx.Request.Context(), userID, req.Query, req.ToRepositoryFilters(userID), req.Limit)
 	if err != nil {
 		c.handleError(ctx, err)
 		return
 	}
 
-	response := dto.ToTransactionResponseList(transactions)
+	response := dto.ToTransactionSearchResponseList(hits)
 	ctx.JSON(http.StatusOK, response)
 }
 
+func (c *TransactionController) GetTransactions(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	// Parse filters, sorting and cursor from query parameters
+	var req dto.ListTransactionsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pageRequest, err := req.ToPageRequest()
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	// Convert DTO filters to repository filters
+	repoFilters := req.ToRepositoryFilters(userID)
+
+	page, err := c.transactionService.ListTransactions(ctx.Request.Context(), userID, repoFilters, pageRequest)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ToTransactionPageResponse(page))
+}
+
 func (c *TransactionController) GetTransaction(ctx *gin.Context) {
 	userID := ctx.GetUint("user_id")
 
@@ -155,6 +282,98 @@ func (c *TransactionController) TogglePaid(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+func (c *TransactionController) CreateTransfer(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	var req dto.CreateTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	legs, err := c.transactionService.CreateTransfer(ctx.Request.Context(), userID, req.FromAccountID, req.ToAccountID, req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTransactionResponseList(legs)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *TransactionController) GetInstallments(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	installments, err := c.transactionService.GetInstallments(ctx.Request.Context(), userID, uint(transactionID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTransactionResponseList(installments)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *TransactionController) UpdateInstallments(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dto.UpdateInstallmentsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	installments, err := c.transactionService.UpdateRemainingInstallments(ctx.Request.Context(), userID, uint(transactionID), req.ToEntity(userID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToTransactionResponseList(installments)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *TransactionController) CancelInstallments(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	err = c.transactionService.CancelRemainingInstallments(ctx.Request.Context(), userID, uint(transactionID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *TransactionController) GenerateRecurring(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	if err := c.transactionService.GenerateRecurringTransactions(ctx.Request.Context(), userID); err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
 func (c *TransactionController) GetReports(ctx *gin.Context) {
 	userID := ctx.GetUint("user_id")
 
@@ -210,6 +429,129 @@ func (c *TransactionController) GetDashboardReports(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, reports)
 }
 
+func (c *TransactionController) UploadAttachment(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo não enviado no campo 'file'"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Não foi possível ler o arquivo enviado"})
+		return
+	}
+	defer file.Close()
+
+	attachment, err := c.attachmentService.UploadAttachment(ctx.Request.Context(), userID, uint(transactionID), fileHeader.Filename, file)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToAttachmentResponse(attachment)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *TransactionController) GetAttachments(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	attachments, err := c.attachmentService.GetAttachments(ctx.Request.Context(), userID, uint(transactionID))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	response := dto.ToAttachmentResponseList(attachments)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *TransactionController) DownloadAttachment(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, attachmentID, ok := parseAttachmentParams(ctx)
+	if !ok {
+		return
+	}
+
+	attachment, content, err := c.attachmentService.OpenAttachment(ctx.Request.Context(), userID, transactionID, attachmentID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+	defer content.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName})
+	ctx.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+		"Content-Disposition": disposition,
+	})
+}
+
+func (c *TransactionController) DownloadAttachmentThumbnail(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, attachmentID, ok := parseAttachmentParams(ctx)
+	if !ok {
+		return
+	}
+
+	_, content, err := c.attachmentService.OpenThumbnail(ctx.Request.Context(), userID, transactionID, attachmentID)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+	defer content.Close()
+
+	ctx.DataFromReader(http.StatusOK, -1, "image/jpeg", content, nil)
+}
+
+func (c *TransactionController) DeleteAttachment(ctx *gin.Context) {
+	userID := ctx.GetUint("user_id")
+
+	transactionID, attachmentID, ok := parseAttachmentParams(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.attachmentService.DeleteAttachment(ctx.Request.Context(), userID, transactionID, attachmentID); err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// parseAttachmentParams lê os IDs da transação e do anexo da rota, respondendo 400 quando inválidos
+func parseAttachmentParams(ctx *gin.Context) (uint, uint, bool) {
+	transactionID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return 0, 0, false
+	}
+
+	attachmentID, err := strconv.ParseUint(ctx.Param("attachmentId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID do anexo inválido"})
+		return 0, 0, false
+	}
+
+	return uint(transactionID), uint(attachmentID), true
+}
+
 func (c *TransactionController) handleError(ctx *gin.Context, err error) {
 	if domainErr, ok := err.(pkgErrors.DomainError); ok {
 		ctx.JSON(domainErr.HTTPStatus(), gin.H{"error": domainErr.Message})
@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Request DTOs
+type CreateAccountRequest struct {
+	Name           string               `json:"name" binding:"required,min=2,max=100"`
+	Kind           entities.AccountKind `json:"kind" binding:"required,oneof=checking savings cash credit_card investment"`
+	Institution    string               `json:"institution" binding:"max=100"`
+	OpeningBalance float64              `json:"opening_balance"`
+	Currency       string               `json:"currency" binding:"omitempty,len=3,uppercase"`
+	ClosingDay     int                  `json:"closing_day" binding:"omitempty,min=1,max=31"`
+	DueDay         int                  `json:"due_day" binding:"omitempty,min=1,max=31"`
+}
+
+type UpdateAccountRequest struct {
+	Name           string               `json:"name" binding:"required,min=2,max=100"`
+	Kind           entities.AccountKind `json:"kind" binding:"required,oneof=checking savings cash credit_card investment"`
+	Institution    string               `json:"institution" binding:"max=100"`
+	OpeningBalance float64              `json:"opening_balance"`
+	Currency       string               `json:"currency" binding:"omitempty,len=3,uppercase"`
+	ClosingDay     int                  `json:"closing_day" binding:"omitempty,min=1,max=31"`
+	DueDay         int                  `json:"due_day" binding:"omitempty,min=1,max=31"`
+}
+
+type ArchiveAccountRequest struct {
+	Archived bool `json:"archived"`
+}
+
+type AccountFiltersRequest struct {
+	IncludeArchived bool `form:"include_archived"`
+}
+
+// Response DTOs
+type AccountResponse struct {
+	ID             uint                 `json:"id"`
+	Name           string               `json:"name"`
+	Kind           entities.AccountKind `json:"kind"`
+	Institution    string               `json:"institution"`
+	OpeningBalance float64              `json:"opening_balance"`
+	Currency       string               `json:"currency"`
+	Archived       bool                 `json:"archived"`
+	ClosingDay     int                  `json:"closing_day,omitempty"`
+	DueDay         int                  `json:"due_day,omitempty"`
+	UserID         uint                 `json:"user_id"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
+
+// Mappers
+func ToAccountResponse(account *entities.Account) AccountResponse {
+	return AccountResponse{
+		ID:             account.ID,
+		Name:           account.Name,
+		Kind:           account.Kind,
+		Institution:    account.Institution,
+		OpeningBalance: account.OpeningBalance,
+		Currency:       account.Currency,
+		Archived:       account.Archived,
+		ClosingDay:     account.ClosingDay,
+		DueDay:         account.DueDay,
+		UserID:         account.UserID,
+		CreatedAt:      account.CreatedAt,
+		UpdatedAt:      account.UpdatedAt,
+	}
+}
+
+func ToAccountResponseList(accounts []*entities.Account) []AccountResponse {
+	result := make([]AccountResponse, len(accounts))
+	for i, account := range accounts {
+		result[i] = ToAccountResponse(account)
+	}
+	return result
+}
+
+func (req *CreateAccountRequest) ToEntity(userID uint) *entities.Account {
+	account := entities.NewAccount(req.Name, req.Kind, req.Institution, req.OpeningBalance, req.Currency, userID)
+	account.SetCardCycle(req.ClosingDay, req.DueDay)
+	return account
+}
+
+func (req *UpdateAccountRequest) ToEntity(userID uint) *entities.Account {
+	account := entities.NewAccount(req.Name, req.Kind, req.Institution, req.OpeningBalance, req.Currency, userID)
+	account.SetCardCycle(req.ClosingDay, req.DueDay)
+	return account
+}
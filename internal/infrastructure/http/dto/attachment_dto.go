@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"fmt"
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Response DTOs
+type AttachmentResponse struct {
+	ID            uint      `json:"id"`
+	TransactionID uint      `json:"transaction_id"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	DownloadURL   string    `json:"download_url"`
+	ThumbnailURL  string    `json:"thumbnail_url,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Mappers
+func ToAttachmentResponse(attachment *entities.Attachment) AttachmentResponse {
+	downloadURL := fmt.Sprintf("/api/v1/transactions/%d/attachments/%d", attachment.TransactionID, attachment.ID)
+
+	response := AttachmentResponse{
+		ID:            attachment.ID,
+		TransactionID: attachment.TransactionID,
+		FileName:      attachment.FileName,
+		ContentType:   attachment.ContentType,
+		Size:          attachment.Size,
+		DownloadURL:   downloadURL,
+		CreatedAt:     attachment.CreatedAt,
+	}
+
+	if attachment.HasThumbnail() {
+		response.ThumbnailURL = downloadURL + "/thumbnail"
+	}
+
+	return response
+}
+
+func ToAttachmentResponseList(attachments []*entities.Attachment) []AttachmentResponse {
+	result := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = ToAttachmentResponse(attachment)
+	}
+	return result
+}
@@ -0,0 +1,160 @@
+package dto
+
+import (
+	"math"
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+const monthLayout = "2006-01"
+
+// Request DTOs
+type BudgetRequest struct {
+	CategoryID uint    `json:"category_id" binding:"required"`
+	Amount     float64 `json:"amount" binding:"required,gt=0"`
+	Rollover   bool    `json:"rollover"`
+	StartMonth string  `json:"start_month" binding:"omitempty,datetime=2006-01"`
+}
+
+type UpdateBudgetRequest struct {
+	Amount     float64 `json:"amount" binding:"required,gt=0"`
+	Rollover   bool    `json:"rollover"`
+	StartMonth string  `json:"start_month" binding:"omitempty,datetime=2006-01"`
+}
+
+type BudgetStatusRequest struct {
+	Year  int `form:"year" binding:"omitempty,min=2000,max=2100"`
+	Month int `form:"month" binding:"omitempty,min=1,max=12"`
+}
+
+// Response DTOs
+type BudgetResponse struct {
+	ID         uint      `json:"id"`
+	CategoryID uint      `json:"category_id"`
+	Amount     float64   `json:"amount"`
+	Rollover   bool      `json:"rollover"`
+	StartMonth string    `json:"start_month"`
+	UserID     uint      `json:"user_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type BudgetStatusResponse struct {
+	BudgetID     uint    `json:"budget_id"`
+	CategoryID   uint    `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	Rollover     bool    `json:"rollover"`
+	Planned      float64 `json:"planned"`
+	Carryover    float64 `json:"carryover"`
+	Available    float64 `json:"available"`
+	Actual       float64 `json:"actual"`
+	Remaining    float64 `json:"remaining"`
+	PercentUsed  float64 `json:"percent_used"`
+	Exceeded     bool    `json:"exceeded"`
+}
+
+type BudgetReportResponse struct {
+	Month     string                 `json:"month"`
+	Planned   float64                `json:"planned"`
+	Available float64                `json:"available"`
+	Actual    float64                `json:"actual"`
+	Remaining float64                `json:"remaining"`
+	Budgets   []BudgetStatusResponse `json:"budgets"`
+}
+
+// Mappers
+func ToBudgetResponse(budget *entities.Budget) BudgetResponse {
+	return BudgetResponse{
+		ID:         budget.ID,
+		CategoryID: budget.CategoryID,
+		Amount:     budget.Amount,
+		Rollover:   budget.Rollover,
+		StartMonth: budget.StartMonth.Format(monthLayout),
+		UserID:     budget.UserID,
+		CreatedAt:  budget.CreatedAt,
+		UpdatedAt:  budget.UpdatedAt,
+	}
+}
+
+func ToBudgetResponseList(budgets []*entities.Budget) []BudgetResponse {
+	result := make([]BudgetResponse, len(budgets))
+	for i, budget := range budgets {
+		result[i] = ToBudgetResponse(budget)
+	}
+	return result
+}
+
+func ToBudgetStatusResponse(status *entities.BudgetStatus) BudgetStatusResponse {
+	percentUsed := 0.0
+	if status.Available > 0 {
+		percentUsed = math.Round(status.Actual/status.Available*10000) / 100
+	}
+
+	return BudgetStatusResponse{
+		BudgetID:     status.Budget.ID,
+		CategoryID:   status.Budget.CategoryID,
+		CategoryName: status.CategoryName,
+		Rollover:     status.Budget.Rollover,
+		Planned:      status.Planned,
+		Carryover:    status.Carryover,
+		Available:    status.Available,
+		Actual:       status.Actual,
+		Remaining:    status.Remaining,
+		PercentUsed:  percentUsed,
+		Exceeded:     status.Remaining < 0,
+	}
+}
+
+func ToBudgetReportResponse(year, month int, statuses []*entities.BudgetStatus) BudgetReportResponse {
+	response := BudgetReportResponse{
+		Month:   time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC).Format(monthLayout),
+		Budgets: make([]BudgetStatusResponse, len(statuses)),
+	}
+
+	for i, status := range statuses {
+		response.Planned += status.Planned
+		response.Available += status.Available
+		response.Actual += status.Actual
+		response.Remaining += status.Remaining
+		response.Budgets[i] = ToBudgetStatusResponse(status)
+	}
+
+	return response
+}
+
+func (req *BudgetRequest) ToEntity(userID uint) *entities.Budget {
+	return &entities.Budget{
+		UserID:     userID,
+		CategoryID: req.CategoryID,
+		Amount:     req.Amount,
+		Rollover:   req.Rollover,
+		StartMonth: parseMonth(req.StartMonth),
+	}
+}
+
+func (req *UpdateBudgetRequest) ToEntity() *entities.Budget {
+	return &entities.Budget{
+		Amount:     req.Amount,
+		Rollover:   req.Rollover,
+		StartMonth: parseMonth(req.StartMonth),
+	}
+}
+
+// Period retorna o ano e o mês solicitados; ausentes, assumem o mês atual
+func (req *BudgetStatusRequest) Period() (int, int) {
+	now := time.Now()
+	year, month := req.Year, req.Month
+	if year == 0 {
+		year = now.Year()
+	}
+	if month == 0 {
+		month = int(now.Month())
+	}
+	return year, month
+}
+
+// parseMonth interpreta um mês AAAA-MM já validado; vazio resulta em time.Time zero
+func parseMonth(value string) time.Time {
+	month, _ := time.Parse(monthLayout, value)
+	return month
+}
@@ -0,0 +1,165 @@
+package dto
+
+import (
+	"fmt"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"time"
+)
+
+var (
+	errInvalidMonth        = pkgErrors.NewDomainError("validation_error", "Mês inválido, use o formato AAAA-MM")
+	errEnvelopeMonthTooFar = pkgErrors.NewDomainError("validation_error",
+		fmt.Sprintf("Mês deve ser no máximo %d meses depois do atual", entities.EnvelopeMaxMonthsAhead))
+)
+
+// Request DTOs
+type EnableEnvelopesRequest struct {
+	StartMonth string `json:"start_month" binding:"omitempty,datetime=2006-01"`
+}
+
+type AssignEnvelopeRequest struct {
+	Month      string  `json:"month" binding:"required,datetime=2006-01"`
+	CategoryID uint    `json:"category_id" binding:"required"`
+	Amount     float64 `json:"amount" binding:"required"`
+	Note       string  `json:"note" binding:"max=255"`
+}
+
+type MoveEnvelopeRequest struct {
+	Month          string  `json:"month" binding:"required,datetime=2006-01"`
+	FromCategoryID uint    `json:"from_category_id" binding:"required"`
+	ToCategoryID   uint    `json:"to_category_id" binding:"required"`
+	Amount         float64 `json:"amount" binding:"required,gt=0"`
+	Note           string  `json:"note" binding:"max=255"`
+}
+
+type EnvelopeLedgerRequest struct {
+	Month      string `form:"month" binding:"omitempty,datetime=2006-01"`
+	CategoryID *uint  `form:"category_id"`
+}
+
+// Response DTOs
+type EnvelopeSettingsResponse struct {
+	StartMonth string    `json:"start_month"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type EnvelopeMonthResponse struct {
+	Month               string                    `json:"month"`
+	CarriedToBeAssigned float64                   `json:"carried_to_be_assigned"`
+	Income              float64                   `json:"income"`
+	Assigned            float64                   `json:"assigned"`
+	ToBeAssigned        float64                   `json:"to_be_assigned"`
+	Envelopes           []EnvelopeBalanceResponse `json:"envelopes"`
+}
+
+type EnvelopeBalanceResponse struct {
+	CategoryID   uint    `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	Carryover    float64 `json:"carryover"`
+	Assigned     float64 `json:"assigned"`
+	Moved        float64 `json:"moved"`
+	Activity     float64 `json:"activity"`
+	Available    float64 `json:"available"`
+	Overspent    bool    `json:"overspent"`
+}
+
+type EnvelopeEntryResponse struct {
+	ID                uint      `json:"id"`
+	CategoryID        *uint     `json:"category_id"`
+	RelatedCategoryID *uint     `json:"related_category_id,omitempty"`
+	Month             string    `json:"month"`
+	Kind              string    `json:"kind"`
+	Amount            float64   `json:"amount"`
+	Note              string    `json:"note,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// Mappers
+func ToEnvelopeSettingsResponse(settings *entities.EnvelopeSettings) EnvelopeSettingsResponse {
+	return EnvelopeSettingsResponse{
+		StartMonth: settings.StartMonth.Format(monthLayout),
+		CreatedAt:  settings.CreatedAt,
+	}
+}
+
+func ToEnvelopeMonthResponse(month *entities.EnvelopeMonth) EnvelopeMonthResponse {
+	response := EnvelopeMonthResponse{
+		Month:               month.Month.Format(monthLayout),
+		CarriedToBeAssigned: month.CarriedToBeAssigned,
+		Income:              month.Income,
+		Assigned:            month.Assigned,
+		ToBeAssigned:        month.ToBeAssigned,
+		Envelopes:           make([]EnvelopeBalanceResponse, len(month.Envelopes)),
+	}
+
+	for i, balance := range month.Envelopes {
+		response.Envelopes[i] = EnvelopeBalanceResponse{
+			CategoryID:   balance.CategoryID,
+			CategoryName: balance.CategoryName,
+			Carryover:    balance.Carryover,
+			Assigned:     balance.Assigned,
+			Moved:        balance.Moved,
+			Activity:     balance.Activity,
+			Available:    balance.Available,
+			Overspent:    balance.Available < 0,
+		}
+	}
+
+	return response
+}
+
+func ToEnvelopeEntryResponseList(entries []*entities.EnvelopeEntry) []EnvelopeEntryResponse {
+	result := make([]EnvelopeEntryResponse, len(entries))
+	for i, entry := range entries {
+		result[i] = EnvelopeEntryResponse{
+			ID:                entry.ID,
+			CategoryID:        entry.CategoryID,
+			RelatedCategoryID: entry.RelatedCategoryID,
+			Month:             entry.Month.Format(monthLayout),
+			Kind:              string(entry.Kind),
+			Amount:            entry.Amount,
+			Note:              entry.Note,
+			CreatedAt:         entry.CreatedAt,
+		}
+	}
+	return result
+}
+
+func (req *EnableEnvelopesRequest) StartMonthValue() (time.Time, error) {
+	if req.StartMonth == "" {
+		return time.Time{}, nil
+	}
+	return ParseMonth(req.StartMonth)
+}
+
+func (req *AssignEnvelopeRequest) MonthValue() (time.Time, error) {
+	return ParseMonth(req.Month)
+}
+
+func (req *MoveEnvelopeRequest) MonthValue() (time.Time, error) {
+	return ParseMonth(req.Month)
+}
+
+func (req *EnvelopeLedgerRequest) ToRepositoryFilters() *repositories.EnvelopeEntryFilters {
+	filters := &repositories.EnvelopeEntryFilters{CategoryID: req.CategoryID}
+	if req.Month != "" {
+		month := parseMonth(req.Month)
+		filters.Month = &month
+	}
+	return filters
+}
+
+// ParseMonth interpreta um mês AAAA-MM do orçamento por envelopes, limitado a
+// EnvelopeMaxMonthsAhead meses depois do atual
+func ParseMonth(value string) (time.Time, error) {
+	month, err := time.Parse(monthLayout, value)
+	if err != nil {
+		return time.Time{}, errInvalidMonth
+	}
+	if month.After(entities.EnvelopeHorizon(time.Now())) {
+		return time.Time{}, errEnvelopeMonthTooFar
+	}
+	return month, nil
+}
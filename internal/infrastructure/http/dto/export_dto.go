@@ -0,0 +1,7 @@
+package dto
+
+// Request DTOs
+type ExportRequest struct {
+	TransactionFiltersRequest
+	Format string `form:"format" binding:"omitempty,oneof=csv xlsx ofx"`
+}
@@ -7,26 +7,56 @@ import (
 
 // Request DTOs
 type CreateGoalRequest struct {
-	Name        string  `json:"name" binding:"required,min=2,max=100"`
-	Description string  `json:"description" binding:"required,max=500"`
-	Amount      float64 `json:"amount" binding:"required,gt=0"`
+	Name        string     `json:"name" binding:"required,min=2,max=100"`
+	Description string     `json:"description" binding:"required,max=500"`
+	Amount      float64    `json:"amount" binding:"required,gt=0"`
+	Kind        string     `json:"kind" binding:"omitempty,oneof=accumulate spend_limit"`
+	StartDate   *time.Time `json:"start_date"`
+	TargetDate  *time.Time `json:"target_date"`
+	CategoryIDs []uint     `json:"category_ids" binding:"omitempty,dive,gt=0"`
+	TagIDs      []uint     `json:"tag_ids" binding:"omitempty,dive,gt=0"`
+	AccountIDs  []uint     `json:"account_ids" binding:"omitempty,dive,gt=0"`
 }
 
 type UpdateGoalRequest struct {
-	Name        string  `json:"name" binding:"required,min=2,max=100"`
-	Description string  `json:"description" binding:"required,max=500"`
-	Amount      float64 `json:"amount" binding:"required,gt=0"`
+	Name        string     `json:"name" binding:"required,min=2,max=100"`
+	Description string     `json:"description" binding:"required,max=500"`
+	Amount      float64    `json:"amount" binding:"required,gt=0"`
+	Kind        string     `json:"kind" binding:"omitempty,oneof=accumulate spend_limit"`
+	StartDate   *time.Time `json:"start_date"`
+	TargetDate  *time.Time `json:"target_date"`
+	CategoryIDs []uint     `json:"category_ids" binding:"omitempty,dive,gt=0"`
+	TagIDs      []uint     `json:"tag_ids" binding:"omitempty,dive,gt=0"`
+	AccountIDs  []uint     `json:"account_ids" binding:"omitempty,dive,gt=0"`
 }
 
 // Response DTOs
 type GoalResponse struct {
-	ID          uint      `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Amount      float64   `json:"amount"`
-	UserID      uint      `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uint       `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Amount      float64    `json:"amount"`
+	Kind        string     `json:"kind"`
+	StartDate   time.Time  `json:"start_date"`
+	TargetDate  *time.Time `json:"target_date"`
+	CategoryIDs []uint     `json:"category_ids"`
+	TagIDs      []uint     `json:"tag_ids"`
+	AccountIDs  []uint     `json:"account_ids"`
+	UserID      uint       `json:"user_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type GoalProgressResponse struct {
+	Goal                    GoalResponse `json:"goal"`
+	Current                 float64      `json:"current"`
+	Remaining               float64      `json:"remaining"`
+	PercentComplete         float64      `json:"percent_complete"`
+	MonthlyAverage          float64      `json:"monthly_average"`
+	ProjectedCompletionDate *time.Time   `json:"projected_completion_date"`
+	RequiredMonthly         *float64     `json:"required_monthly"`
+	Completed               bool         `json:"completed"`
+	Exceeded                bool         `json:"exceeded"`
 }
 
 // Mappers
@@ -36,6 +66,12 @@ func ToGoalResponse(goal *entities.Goal) GoalResponse {
 		Name:        goal.Name,
 		Description: goal.Description,
 		Amount:      goal.Amount,
+		Kind:        string(goal.Kind),
+		StartDate:   goal.StartDate,
+		TargetDate:  goal.TargetDate,
+		CategoryIDs: idsOrEmpty(goal.CategoryIDs),
+		TagIDs:      idsOrEmpty(goal.TagIDs),
+		AccountIDs:  idsOrEmpty(goal.AccountIDs),
 		UserID:      goal.UserID,
 		CreatedAt:   goal.CreatedAt,
 		UpdatedAt:   goal.UpdatedAt,
@@ -50,10 +86,48 @@ func ToGoalResponseList(goals []*entities.Goal) []GoalResponse {
 	return result
 }
 
+func ToGoalProgressResponse(progress *entities.GoalProgress) GoalProgressResponse {
+	return GoalProgressResponse{
+		Goal:                    ToGoalResponse(progress.Goal),
+		Current:                 progress.Current,
+		Remaining:               progress.Remaining,
+		PercentComplete:         progress.PercentComplete,
+		MonthlyAverage:          progress.MonthlyAverage,
+		ProjectedCompletionDate: progress.ProjectedCompletionDate,
+		RequiredMonthly:         progress.RequiredMonthly,
+		Completed:               progress.Completed,
+		Exceeded:                progress.Exceeded,
+	}
+}
+
+func ToGoalProgressResponseList(progress []*entities.GoalProgress) []GoalProgressResponse {
+	result := make([]GoalProgressResponse, len(progress))
+	for i, item := range progress {
+		result[i] = ToGoalProgressResponse(item)
+	}
+	return result
+}
+
 func (req *CreateGoalRequest) ToEntity(userID uint) *entities.Goal {
-	return entities.NewGoal(req.Name, req.Description, req.Amount, userID)
+	return newGoalEntity(req.Name, req.Description, req.Amount, userID, req.Kind, req.StartDate, req.TargetDate, req.CategoryIDs, req.TagIDs, req.AccountIDs)
 }
 
 func (req *UpdateGoalRequest) ToEntity(userID uint) *entities.Goal {
-	return entities.NewGoal(req.Name, req.Description, req.Amount, userID)
+	return newGoalEntity(req.Name, req.Description, req.Amount, userID, req.Kind, req.StartDate, req.TargetDate, req.CategoryIDs, req.TagIDs, req.AccountIDs)
+}
+
+// newGoalEntity monta a meta da requisição; campos de acompanhamento não informados
+// ficam vazios para que o serviço aplique os valores padrão ou os atuais
+func newGoalEntity(name, description string, amount float64, userID uint, kind string, startDate, targetDate *time.Time, categoryIDs, tagIDs, accountIDs []uint) *entities.Goal {
+	goal := entities.NewGoal(name, description, amount, userID)
+	goal.Kind = entities.GoalKind(kind)
+	goal.StartDate = time.Time{}
+	if startDate != nil {
+		goal.StartDate = *startDate
+	}
+	goal.TargetDate = targetDate
+	goal.CategoryIDs = categoryIDs
+	goal.TagIDs = tagIDs
+	goal.AccountIDs = accountIDs
+	return goal
 }
@@ -0,0 +1,189 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Request DTOs
+type ImportRequest struct {
+	AccountID *uint `form:"account_id"`
+	Commit    bool  `form:"commit"`
+}
+
+type ImportCSVRequest struct {
+	ProfileID uint  `form:"profile_id" binding:"required"`
+	AccountID *uint `form:"account_id"`
+	Commit    bool  `form:"commit"`
+}
+
+type ImportProfileRequest struct {
+	Name              string `json:"name" binding:"required,min=1,max=100"`
+	AccountID         *uint  `json:"account_id"`
+	Delimiter         string `json:"delimiter" binding:"omitempty,max=2"`
+	Encoding          string `json:"encoding" binding:"omitempty,max=20"`
+	DateFormat        string `json:"date_format" binding:"omitempty,max=20"`
+	DecimalSeparator  string `json:"decimal_separator" binding:"omitempty,max=1"`
+	HasHeader         *bool  `json:"has_header"`
+	SkipRows          int    `json:"skip_rows" binding:"min=0"`
+	DateColumn        *int   `json:"date_column" binding:"required,min=0"`
+	DescriptionColumn *int   `json:"description_column" binding:"required,min=0"`
+	AmountColumn      *int   `json:"amount_column" binding:"omitempty,min=0"`
+	DebitColumn       *int   `json:"debit_column" binding:"omitempty,min=0"`
+	CreditColumn      *int   `json:"credit_column" binding:"omitempty,min=0"`
+	CategoryColumn    *int   `json:"category_column" binding:"omitempty,min=0"`
+	InvertAmount      bool   `json:"invert_amount"`
+}
+
+// Response DTOs
+type ImportItemResponse struct {
+	Line                int                      `json:"line,omitempty"`
+	TransactionID       *uint                    `json:"transaction_id"`
+	ExternalID          string                   `json:"external_id,omitempty"`
+	Description         string                   `json:"description,omitempty"`
+	Amount              float64                  `json:"amount,omitempty"`
+	Type                entities.TransactionType `json:"type,omitempty"`
+	Date                *time.Time               `json:"date,omitempty"`
+	CategoryID          *uint                    `json:"category_id,omitempty"`
+	PayeeID             *uint                    `json:"payee_id,omitempty"`
+	Duplicate           bool                     `json:"duplicate"`
+	DuplicateCandidates []uint                   `json:"duplicate_candidates,omitempty"`
+	Error               string                   `json:"error,omitempty"`
+	Warning             string                   `json:"warning,omitempty"`
+}
+
+type ImportResultResponse struct {
+	Committed  bool                 `json:"committed"`
+	Total      int                  `json:"total"`
+	Imported   int                  `json:"imported"`
+	Duplicates int                  `json:"duplicates"`
+	Invalid    int                  `json:"invalid"`
+	Items      []ImportItemResponse `json:"items"`
+}
+
+type ImportProfileResponse struct {
+	ID                uint      `json:"id"`
+	Name              string    `json:"name"`
+	AccountID         *uint     `json:"account_id"`
+	Delimiter         string    `json:"delimiter"`
+	Encoding          string    `json:"encoding"`
+	DateFormat        string    `json:"date_format"`
+	DecimalSeparator  string    `json:"decimal_separator"`
+	HasHeader         bool      `json:"has_header"`
+	SkipRows          int       `json:"skip_rows"`
+	DateColumn        int       `json:"date_column"`
+	DescriptionColumn int       `json:"description_column"`
+	AmountColumn      *int      `json:"amount_column"`
+	DebitColumn       *int      `json:"debit_column"`
+	CreditColumn      *int      `json:"credit_column"`
+	CategoryColumn    *int      `json:"category_column"`
+	InvertAmount      bool      `json:"invert_amount"`
+	UserID            uint      `json:"user_id"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Mappers
+func ToImportResultResponse(result *entities.ImportResult) ImportResultResponse {
+	items := make([]ImportItemResponse, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = ImportItemResponse{
+			Line:                item.Line,
+			Duplicate:           item.Duplicate,
+			DuplicateCandidates: item.DuplicateCandidates,
+			Error:               item.Error,
+			Warning:             item.Warning,
+		}
+
+		transaction := item.Transaction
+		if transaction == nil {
+			continue
+		}
+
+		date := transaction.Date
+		items[i].ExternalID = transaction.ExternalID
+		items[i].Description = transaction.Description
+		items[i].Amount = transaction.Amount
+		items[i].Type = transaction.Type
+		items[i].Date = &date
+		items[i].CategoryID = transaction.CategoryID
+		items[i].PayeeID = transaction.PayeeID
+		if transaction.ID != 0 {
+			id := transaction.ID
+			items[i].TransactionID = &id
+		}
+	}
+
+	return ImportResultResponse{
+		Committed:  result.Committed,
+		Total:      len(result.Items),
+		Imported:   result.Imported,
+		Duplicates: result.Duplicates,
+		Invalid:    result.Invalid,
+		Items:      items,
+	}
+}
+
+func ToImportProfileResponse(profile *entities.ImportProfile) ImportProfileResponse {
+	return ImportProfileResponse{
+		ID:                profile.ID,
+		Name:              profile.Name,
+		AccountID:         profile.AccountID,
+		Delimiter:         profile.Delimiter,
+		Encoding:          profile.Encoding,
+		DateFormat:        profile.DateFormat,
+		DecimalSeparator:  profile.DecimalSeparator,
+		HasHeader:         profile.HasHeader,
+		SkipRows:          profile.SkipRows,
+		DateColumn:        profile.DateColumn,
+		DescriptionColumn: profile.DescriptionColumn,
+		AmountColumn:      profile.AmountColumn,
+		DebitColumn:       profile.DebitColumn,
+		CreditColumn:      profile.CreditColumn,
+		CategoryColumn:    profile.CategoryColumn,
+		InvertAmount:      profile.InvertAmount,
+		UserID:            profile.UserID,
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
+	}
+}
+
+func ToImportProfileResponseList(profiles []*entities.ImportProfile) []ImportProfileResponse {
+	result := make([]ImportProfileResponse, len(profiles))
+	for i, profile := range profiles {
+		result[i] = ToImportProfileResponse(profile)
+	}
+	return result
+}
+
+// ToEntity aplica sobre os padrões do perfil (";", ",", DD/MM/YYYY) apenas os campos informados
+func (req *ImportProfileRequest) ToEntity(userID uint) *entities.ImportProfile {
+	profile := entities.NewImportProfile(req.Name, userID)
+	profile.AccountID = req.AccountID
+	profile.SkipRows = req.SkipRows
+	profile.DateColumn = *req.DateColumn
+	profile.DescriptionColumn = *req.DescriptionColumn
+	profile.AmountColumn = req.AmountColumn
+	profile.DebitColumn = req.DebitColumn
+	profile.CreditColumn = req.CreditColumn
+	profile.CategoryColumn = req.CategoryColumn
+	profile.InvertAmount = req.InvertAmount
+
+	if req.Delimiter != "" {
+		profile.Delimiter = req.Delimiter
+	}
+	if req.Encoding != "" {
+		profile.Encoding = req.Encoding
+	}
+	if req.DateFormat != "" {
+		profile.DateFormat = req.DateFormat
+	}
+	if req.DecimalSeparator != "" {
+		profile.DecimalSeparator = req.DecimalSeparator
+	}
+	if req.HasHeader != nil {
+		profile.HasHeader = *req.HasHeader
+	}
+
+	return profile
+}
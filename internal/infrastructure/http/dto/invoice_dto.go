@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Request DTOs
+type PayInvoiceRequest struct {
+	FromAccountID uint      `json:"from_account_id" binding:"required"`
+	Date          time.Time `json:"date"`
+}
+
+type InvoiceFiltersRequest struct {
+	Status *entities.InvoiceStatus `form:"status" binding:"omitempty,oneof=open closed overdue paid"`
+}
+
+// Response DTOs
+type InvoiceResponse struct {
+	ID                   uint                   `json:"id"`
+	AccountID            uint                   `json:"account_id"`
+	Year                 int                    `json:"year"`
+	Month                int                    `json:"month"`
+	ClosingDate          time.Time              `json:"closing_date"`
+	DueDate              time.Time              `json:"due_date"`
+	Status               entities.InvoiceStatus `json:"status"`
+	Total                float64                `json:"total"`
+	PaidAt               *time.Time             `json:"paid_at"`
+	PaymentTransactionID *uint                  `json:"payment_transaction_id"`
+	CreatedAt            time.Time              `json:"created_at"`
+	UpdatedAt            time.Time              `json:"updated_at"`
+}
+
+type InvoiceDetailResponse struct {
+	InvoiceResponse
+	Items []TransactionResponse `json:"items"`
+}
+
+// Mappers
+func ToInvoiceResponse(invoice *entities.Invoice) InvoiceResponse {
+	return InvoiceResponse{
+		ID:                   invoice.ID,
+		AccountID:            invoice.AccountID,
+		Year:                 invoice.Year,
+		Month:                invoice.Month,
+		ClosingDate:          invoice.ClosingDate,
+		DueDate:              invoice.DueDate,
+		Status:               invoice.Status(time.Now()),
+		Total:                invoice.Total,
+		PaidAt:               invoice.PaidAt,
+		PaymentTransactionID: invoice.PaymentTransactionID,
+		CreatedAt:            invoice.CreatedAt,
+		UpdatedAt:            invoice.UpdatedAt,
+	}
+}
+
+func ToInvoiceResponseList(invoices []*entities.Invoice) []InvoiceResponse {
+	result := make([]InvoiceResponse, len(invoices))
+	for i, invoice := range invoices {
+		result[i] = ToInvoiceResponse(invoice)
+	}
+	return result
+}
+
+func ToInvoiceDetailResponse(invoice *entities.Invoice, items []*entities.Transaction) InvoiceDetailResponse {
+	return InvoiceDetailResponse{
+		InvoiceResponse: ToInvoiceResponse(invoice),
+		Items:           ToTransactionResponseList(items),
+	}
+}
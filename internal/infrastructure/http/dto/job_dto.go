@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Response DTOs
+type JobRunResponse struct {
+	ID         uint                  `json:"id"`
+	JobName    string                `json:"job_name"`
+	Trigger    entities.JobTrigger   `json:"trigger"`
+	Status     entities.JobRunStatus `json:"status"`
+	Error      string                `json:"error,omitempty"`
+	StartedAt  time.Time             `json:"started_at"`
+	FinishedAt *time.Time            `json:"finished_at"`
+	DurationMs int64                 `json:"duration_ms"`
+}
+
+type ScheduledJobResponse struct {
+	Name    string          `json:"name"`
+	Spec    string          `json:"spec"`
+	NextRun time.Time       `json:"next_run"`
+	LastRun *JobRunResponse `json:"last_run"`
+}
+
+// Mappers
+func ToJobRunResponse(run *entities.JobRun) JobRunResponse {
+	return JobRunResponse{
+		ID:         run.ID,
+		JobName:    run.JobName,
+		Trigger:    run.Trigger,
+		Status:     run.Status,
+		Error:      run.Error,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+		DurationMs: run.Duration().Milliseconds(),
+	}
+}
+
+func ToJobRunResponseList(runs []*entities.JobRun) []JobRunResponse {
+	result := make([]JobRunResponse, len(runs))
+	for i, run := range runs {
+		result[i] = ToJobRunResponse(run)
+	}
+	return result
+}
+
+func ToScheduledJobResponseList(jobs []*entities.ScheduledJob) []ScheduledJobResponse {
+	result := make([]ScheduledJobResponse, len(jobs))
+	for i, job := range jobs {
+		result[i] = ScheduledJobResponse{
+			Name:    job.Name,
+			Spec:    job.Spec,
+			NextRun: job.NextRun,
+		}
+		if job.LastRun != nil {
+			lastRun := ToJobRunResponse(job.LastRun)
+			result[i].LastRun = &lastRun
+		}
+	}
+	return result
+}
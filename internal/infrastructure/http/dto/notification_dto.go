@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Request DTOs
+type NotificationListRequest struct {
+	Unread bool `form:"unread"`
+	Limit  int  `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type NotificationSettingsRequest struct {
+	BudgetThresholds   []int   `json:"budget_thresholds" binding:"max=5,dive,min=1,max=500"`
+	LargeExpenseAmount float64 `json:"large_expense_amount" binding:"gte=0"`
+	OverdueAlerts      bool    `json:"overdue_alerts"`
+}
+
+// Response DTOs
+type NotificationResponse struct {
+	ID            uint       `json:"id"`
+	Type          string     `json:"type"`
+	Title         string     `json:"title"`
+	Message       string     `json:"message"`
+	TransactionID *uint      `json:"transaction_id,omitempty"`
+	CategoryID    *uint      `json:"category_id,omitempty"`
+	Read          bool       `json:"read"`
+	ReadAt        *time.Time `json:"read_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	Data        []NotificationResponse `json:"data"`
+	UnreadCount int64                  `json:"unread_count"`
+}
+
+type NotificationSettingsResponse struct {
+	BudgetThresholds   []int   `json:"budget_thresholds"`
+	LargeExpenseAmount float64 `json:"large_expense_amount"`
+	OverdueAlerts      bool    `json:"overdue_alerts"`
+}
+
+// Mappers
+func ToNotificationResponse(notification *entities.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:            notification.ID,
+		Type:          string(notification.Type),
+		Title:         notification.Title,
+		Message:       notification.Message,
+		TransactionID: notification.TransactionID,
+		CategoryID:    notification.CategoryID,
+		Read:          notification.IsRead(),
+		ReadAt:        notification.ReadAt,
+		CreatedAt:     notification.CreatedAt,
+	}
+}
+
+func ToNotificationListResponse(notifications []*entities.Notification, unreadCount int64) NotificationListResponse {
+	response := NotificationListResponse{
+		Data:        make([]NotificationResponse, len(notifications)),
+		UnreadCount: unreadCount,
+	}
+	for i, notification := range notifications {
+		response.Data[i] = ToNotificationResponse(notification)
+	}
+	return response
+}
+
+func ToNotificationSettingsResponse(settings *entities.NotificationSettings) NotificationSettingsResponse {
+	thresholds := settings.BudgetThresholds
+	if thresholds == nil {
+		thresholds = []int{}
+	}
+
+	return NotificationSettingsResponse{
+		BudgetThresholds:   thresholds,
+		LargeExpenseAmount: settings.LargeExpenseAmount,
+		OverdueAlerts:      settings.OverdueAlerts,
+	}
+}
+
+func (req *NotificationSettingsRequest) ToEntity(userID uint) *entities.NotificationSettings {
+	return &entities.NotificationSettings{
+		UserID:             userID,
+		BudgetThresholds:   req.BudgetThresholds,
+		LargeExpenseAmount: req.LargeExpenseAmount,
+		OverdueAlerts:      req.OverdueAlerts,
+	}
+}
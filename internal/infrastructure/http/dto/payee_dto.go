@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Request DTOs
+type PayeeRequest struct {
+	Name              string   `json:"name" binding:"required,min=1,max=100"`
+	Aliases           []string `json:"aliases" binding:"omitempty,max=50,dive,max=100"`
+	DefaultCategoryID *uint    `json:"default_category_id"`
+}
+
+// Response DTOs
+type PayeeResponse struct {
+	ID                uint      `json:"id"`
+	Name              string    `json:"name"`
+	Aliases           []string  `json:"aliases"`
+	DefaultCategoryID *uint     `json:"default_category_id"`
+	UserID            uint      `json:"user_id"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Mappers
+func ToPayeeResponse(payee *entities.Payee) PayeeResponse {
+	aliases := payee.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	return PayeeResponse{
+		ID:                payee.ID,
+		Name:              payee.Name,
+		Aliases:           aliases,
+		DefaultCategoryID: payee.DefaultCategoryID,
+		UserID:            payee.UserID,
+		CreatedAt:         payee.CreatedAt,
+		UpdatedAt:         payee.UpdatedAt,
+	}
+}
+
+func ToPayeeResponseList(payees []*entities.Payee) []PayeeResponse {
+	result := make([]PayeeResponse, len(payees))
+	for i, payee := range payees {
+		result[i] = ToPayeeResponse(payee)
+	}
+	return result
+}
+
+func (req *PayeeRequest) ToEntity(userID uint) *entities.Payee {
+	return entities.NewPayee(req.Name, req.Aliases, req.DefaultCategoryID, userID)
+}
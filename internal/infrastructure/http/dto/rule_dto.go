@@ -0,0 +1,160 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Request DTOs
+type RuleRequest struct {
+	Name               string   `json:"name" binding:"required,min=1,max=100"`
+	Priority           int      `json:"priority"`
+	Active             *bool    `json:"active"`
+	DescriptionMatch   string   `json:"description_match" binding:"omitempty,oneof=contains regex"`
+	DescriptionPattern string   `json:"description_pattern" binding:"omitempty,max=255"`
+	MinAmount          *float64 `json:"min_amount" binding:"omitempty,min=0"`
+	MaxAmount          *float64 `json:"max_amount" binding:"omitempty,min=0"`
+	AccountID          *uint    `json:"account_id"`
+	TransactionType    *string  `json:"transaction_type" binding:"omitempty,oneof=income expense investment"`
+	SetCategoryID      *uint    `json:"set_category_id"`
+	SetPayeeID         *uint    `json:"set_payee_id"`
+	SetTagIDs          []uint   `json:"set_tag_ids"`
+}
+
+// RuleRunRequest controla a prévia e a aplicação retroativa de uma regra
+type RuleRunRequest struct {
+	Overwrite bool `form:"overwrite" json:"overwrite"`
+}
+
+// Response DTOs
+type RuleResponse struct {
+	ID                 uint      `json:"id"`
+	Name               string    `json:"name"`
+	Priority           int       `json:"priority"`
+	Active             bool      `json:"active"`
+	DescriptionMatch   string    `json:"description_match"`
+	DescriptionPattern string    `json:"description_pattern"`
+	MinAmount          *float64  `json:"min_amount"`
+	MaxAmount          *float64  `json:"max_amount"`
+	AccountID          *uint     `json:"account_id"`
+	TransactionType    *string   `json:"transaction_type"`
+	SetCategoryID      *uint     `json:"set_category_id"`
+	SetPayeeID         *uint     `json:"set_payee_id"`
+	SetTagIDs          []uint    `json:"set_tag_ids"`
+	UserID             uint      `json:"user_id"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type RuleChangeResponse struct {
+	TransactionID  uint      `json:"transaction_id"`
+	Description    string    `json:"description"`
+	Amount         float64   `json:"amount"`
+	Date           time.Time `json:"date"`
+	OldCategoryID  *uint     `json:"old_category_id"`
+	NewCategoryID  *uint     `json:"new_category_id"`
+	CategoryChange bool      `json:"category_change"`
+	OldPayeeID     *uint     `json:"old_payee_id"`
+	NewPayeeID     *uint     `json:"new_payee_id"`
+	PayeeChange    bool      `json:"payee_change"`
+	AddedTagIDs    []uint    `json:"added_tag_ids"`
+}
+
+type RuleRunResponse struct {
+	Applied bool                 `json:"applied"`
+	Total   int                  `json:"total"`
+	Changes []RuleChangeResponse `json:"changes"`
+}
+
+// Mappers
+func ToRuleResponse(rule *entities.Rule) RuleResponse {
+	response := RuleResponse{
+		ID:                 rule.ID,
+		Name:               rule.Name,
+		Priority:           rule.Priority,
+		Active:             rule.Active,
+		DescriptionMatch:   string(rule.DescriptionMatch),
+		DescriptionPattern: rule.DescriptionPattern,
+		MinAmount:          rule.MinAmount,
+		MaxAmount:          rule.MaxAmount,
+		AccountID:          rule.AccountID,
+		SetCategoryID:      rule.SetCategoryID,
+		SetPayeeID:         rule.SetPayeeID,
+		SetTagIDs:          rule.SetTagIDs,
+		UserID:             rule.UserID,
+		CreatedAt:          rule.CreatedAt,
+		UpdatedAt:          rule.UpdatedAt,
+	}
+
+	if rule.TransactionType != nil {
+		transactionType := string(*rule.TransactionType)
+		response.TransactionType = &transactionType
+	}
+	if response.SetTagIDs == nil {
+		response.SetTagIDs = []uint{}
+	}
+
+	return response
+}
+
+func ToRuleResponseList(rules []*entities.Rule) []RuleResponse {
+	result := make([]RuleResponse, len(rules))
+	for i, rule := range rules {
+		result[i] = ToRuleResponse(rule)
+	}
+	return result
+}
+
+func ToRuleRunResponse(changes []*entities.RuleChange, applied bool) RuleRunResponse {
+	result := make([]RuleChangeResponse, len(changes))
+	for i, change := range changes {
+		result[i] = RuleChangeResponse{
+			TransactionID:  change.Transaction.ID,
+			Description:    change.Transaction.Description,
+			Amount:         change.Transaction.Amount,
+			Date:           change.Transaction.Date,
+			OldCategoryID:  change.OldCategoryID,
+			NewCategoryID:  change.NewCategoryID,
+			CategoryChange: change.CategoryChange,
+			OldPayeeID:     change.OldPayeeID,
+			NewPayeeID:     change.NewPayeeID,
+			PayeeChange:    change.PayeeChange,
+			AddedTagIDs:    change.AddedTagIDs,
+		}
+		if result[i].AddedTagIDs == nil {
+			result[i].AddedTagIDs = []uint{}
+		}
+	}
+
+	return RuleRunResponse{
+		Applied: applied,
+		Total:   len(result),
+		Changes: result,
+	}
+}
+
+func (req *RuleRequest) ToEntity(userID uint) *entities.Rule {
+	rule := entities.NewRule(req.Name, userID)
+	rule.Priority = req.Priority
+	rule.DescriptionMatch = entities.RULE_MATCH_CONTAINS
+	rule.DescriptionPattern = req.DescriptionPattern
+	rule.MinAmount = req.MinAmount
+	rule.MaxAmount = req.MaxAmount
+	rule.AccountID = req.AccountID
+	rule.SetCategoryID = req.SetCategoryID
+	rule.SetPayeeID = req.SetPayeeID
+	rule.SetTagIDs = req.SetTagIDs
+
+	if req.DescriptionMatch != "" {
+		rule.DescriptionMatch = entities.RuleMatchType(req.DescriptionMatch)
+	}
+	if req.TransactionType != nil {
+		transactionType := entities.TransactionType(*req.TransactionType)
+		rule.TransactionType = &transactionType
+	}
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+
+	return rule
+}
@@ -15,14 +15,16 @@ type CreateSavingGoalRequest struct {
 }
 
 type UpdateSavingGoalRequest struct {
-	Name          string  `json:"name" binding:"required,min=2,max=100"`
-	TargetAmount  float64 `json:"target_amount" binding:"required,gt=0"`
-	CurrentAmount float64 `json:"current_amount" binding:"required,gt=0"`
-	Description   string  `json:"description"`
+	Name          string   `json:"name" binding:"required,min=2,max=100"`
+	TargetAmount  float64  `json:"target_amount" binding:"required,gt=0"`
+	CurrentAmount *float64 `json:"current_amount" binding:"required,gte=0"`
+	Description   string   `json:"description"`
 }
 
-type DepositRequest struct {
-	Amount float64 `json:"amount" binding:"required,gt=0"`
+type SavingGoalMovementRequest struct {
+	Amount float64    `json:"amount" binding:"required,gt=0"`
+	Date   *time.Time `json:"date"`
+	Note   string     `json:"note" binding:"max=255"`
 }
 
 // Response DTOs
@@ -38,6 +40,24 @@ type SavingGoalResponse struct {
 	UpdatedAt     time.Time `json:"updated_at"`
 }
 
+type SavingGoalMovementResponse struct {
+	ID        uint      `json:"id"`
+	Kind      string    `json:"kind"`
+	Amount    float64   `json:"amount"`
+	Balance   float64   `json:"balance"`
+	Date      time.Time `json:"date"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SavingGoalHistoryResponse struct {
+	Month       string  `json:"month"`
+	Deposits    float64 `json:"deposits"`
+	Withdrawals float64 `json:"withdrawals"`
+	Adjustments float64 `json:"adjustments"`
+	Balance     float64 `json:"balance"`
+}
+
 // Mappers
 func ToSavingGoalResponse(savingGoal *entities.SavingGoal) SavingGoalResponse {
 	return SavingGoalResponse{
@@ -66,5 +86,44 @@ func (req *CreateSavingGoalRequest) ToEntity(userID uint) *entities.SavingGoal {
 }
 
 func (req *UpdateSavingGoalRequest) ToEntity(userID uint) *entities.SavingGoal {
-	return entities.NewSavingGoal(req.Name, req.TargetAmount, userID, req.CurrentAmount, req.Description)
+	// Ponteiro para que zero seja aceito e a ausência do campo continue sendo rejeitada
+	return entities.NewSavingGoal(req.Name, req.TargetAmount, userID, *req.CurrentAmount, req.Description)
+}
+
+// MovementDate retorna a data informada ou zero, para que o serviço use o momento atual
+func (req *SavingGoalMovementRequest) MovementDate() time.Time {
+	if req.Date == nil {
+		return time.Time{}
+	}
+	return *req.Date
+}
+
+func ToSavingGoalMovementResponseList(movements []*entities.SavingGoalMovement) []SavingGoalMovementResponse {
+	result := make([]SavingGoalMovementResponse, len(movements))
+	for i, movement := range movements {
+		result[i] = SavingGoalMovementResponse{
+			ID:        movement.ID,
+			Kind:      string(movement.Kind),
+			Amount:    movement.Amount,
+			Balance:   movement.Balance,
+			Date:      movement.Date,
+			Note:      movement.Note,
+			CreatedAt: movement.CreatedAt,
+		}
+	}
+	return result
+}
+
+func ToSavingGoalHistoryResponseList(history []entities.SavingGoalHistoryPoint) []SavingGoalHistoryResponse {
+	result := make([]SavingGoalHistoryResponse, len(history))
+	for i, point := range history {
+		result[i] = SavingGoalHistoryResponse{
+			Month:       point.Month,
+			Deposits:    point.Deposits,
+			Withdrawals: point.Withdrawals,
+			Adjustments: point.Adjustments,
+			Balance:     point.Balance,
+		}
+	}
+	return result
 }
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"my-finance-hub-api/internal/domain/entities"
+	"time"
+)
+
+// Request DTOs
+type CreateTagRequest struct {
+	Name  string `json:"name" binding:"required,min=1,max=50"`
+	Color string `json:"color" binding:"omitempty,hexcolor"`
+}
+
+type UpdateTagRequest struct {
+	Name  string `json:"name" binding:"required,min=1,max=50"`
+	Color string `json:"color" binding:"omitempty,hexcolor"`
+}
+
+// Response DTOs
+type TagResponse struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	UserID    uint      `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Mappers
+func ToTagResponse(tag *entities.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		UserID:    tag.UserID,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func ToTagResponseList(tags []*entities.Tag) []TagResponse {
+	result := make([]TagResponse, len(tags))
+	for i, tag := range tags {
+		result[i] = ToTagResponse(tag)
+	}
+	return result
+}
+
+func (req *CreateTagRequest) ToEntity(userID uint) *entities.Tag {
+	return entities.NewTag(req.Name, req.Color, userID)
+}
+
+func (req *UpdateTagRequest) ToEntity(userID uint) *entities.Tag {
+	return entities.NewTag(req.Name, req.Color, userID)
+}
@@ -1,62 +1,218 @@
 package dto
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
 	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+	"strings"
 	"time"
 )
 
+var errInvalidCursor = pkgErrors.NewDomainError("validation_error", "Cursor de paginação inválido")
+
 // Request DTOs
 type CreateTransactionRequest struct {
-	Description    string                   `json:"description" binding:"required,max=255"`
-	Amount         float64                  `json:"amount" binding:"required,gt=0"`
-	Type           entities.TransactionType `json:"type" binding:"required"`
-	Date           time.Time                `json:"date" binding:"required"`
-	CategoryID     *uint                    `json:"category_id"`
-	PiggyBankID    *uint                    `json:"piggy_bank_id"`
-	Paid           bool                     `json:"paid"`
-	IsRecurrent    bool                     `json:"is_recurrent"`
-	RecurrenceType entities.RecurrenceType  `json:"recurrence_type"`
-	RecurrenceEnd  *time.Time               `json:"recurrence_end"`
+	Description          string                    `json:"description" binding:"required,max=255"`
+	Notes                string                    `json:"notes" binding:"max=1000"`
+	Amount               float64                   `json:"amount" binding:"required,gt=0"`
+	Type                 entities.TransactionType  `json:"type" binding:"required"`
+	Date                 time.Time                 `json:"date" binding:"required"`
+	CategoryID           *uint                     `json:"category_id"`
+	PayeeID              *uint                     `json:"payee_id"`
+	PiggyBankID          *uint                     `json:"piggy_bank_id"`
+	AccountID            *uint                     `json:"account_id"`
+	Paid                 bool                      `json:"paid"`
+	IsRecurrent          bool                      `json:"is_recurrent"`
+	RecurrenceType       entities.RecurrenceType   `json:"recurrence_type"`
+	RecurrenceEnd        *time.Time                `json:"recurrence_end"`
+	Installments         int                       `json:"installments" binding:"omitempty,min=1,max=72"`
+	InterestRate         float64                   `json:"interest_rate" binding:"omitempty,gte=0"`
+	InstallmentRemainder string                    `json:"installment_remainder" binding:"omitempty,oneof=first last"`
+	Splits               []TransactionSplitRequest `json:"splits" binding:"omitempty,dive"`
+	TagIDs               []uint                    `json:"tag_ids"`
 }
 
 type UpdateTransactionRequest struct {
-	Description    string                   `json:"description" binding:"required,max=255"`
-	Amount         float64                  `json:"amount" binding:"required,gt=0"`
-	Type           entities.TransactionType `json:"type" binding:"required"`
-	Date           time.Time                `json:"date" binding:"required"`
-	CategoryID     *uint                    `json:"category_id"`
-	PiggyBankID    *uint                    `json:"piggy_bank_id"`
-	Paid           bool                     `json:"paid"`
-	IsRecurrent    bool                     `json:"is_recurrent"`
-	RecurrenceType entities.RecurrenceType  `json:"recurrence_type"`
-	RecurrenceEnd  *time.Time               `json:"recurrence_end"`
+	Description    string                     `json:"description" binding:"required,max=255"`
+	Notes          string                     `json:"notes" binding:"max=1000"`
+	Amount         float64                    `json:"amount" binding:"required,gt=0"`
+	Type           entities.TransactionType   `json:"type" binding:"required"`
+	Date           time.Time                  `json:"date" binding:"required"`
+	CategoryID     *uint                      `json:"category_id"`
+	PayeeID        *uint                      `json:"payee_id"`
+	PiggyBankID    *uint                      `json:"piggy_bank_id"`
+	AccountID      *uint                      `json:"account_id"`
+	Paid           bool                       `json:"paid"`
+	IsRecurrent    bool                       `json:"is_recurrent"`
+	RecurrenceType entities.RecurrenceType    `json:"recurrence_type"`
+	RecurrenceEnd  *time.Time                 `json:"recurrence_end"`
+	Splits         *[]TransactionSplitRequest `json:"splits" binding:"omitempty,dive"`
+	TagIDs         *[]uint                    `json:"tag_ids"`
+}
+
+type TransactionSplitRequest struct {
+	CategoryID uint    `json:"category_id" binding:"required"`
+	Amount     float64 `json:"amount" binding:"required,gt=0"`
+	Memo       string  `json:"memo" binding:"max=255"`
+}
+
+type CreateTransferRequest struct {
+	FromAccountID uint      `json:"from_account_id" binding:"required"`
+	ToAccountID   uint      `json:"to_account_id" binding:"required,nefield=FromAccountID"`
+	Amount        float64   `json:"amount" binding:"required,gt=0"`
+	Date          time.Time `json:"date" binding:"required"`
+	Description   string    `json:"description" binding:"max=255"`
+	Paid          bool      `json:"paid"`
+}
+
+type UpdateInstallmentsRequest struct {
+	Description string  `json:"description" binding:"omitempty,max=255"`
+	Amount      float64 `json:"amount" binding:"omitempty,gt=0"`
+	CategoryID  *uint   `json:"category_id"`
+}
+
+type MergeDuplicatesRequest struct {
+	KeepID       uint   `json:"keep_id" binding:"required"`
+	DuplicateIDs []uint `json:"duplicate_ids" binding:"required,min=1"`
+}
+
+type BulkTransactionRequest struct {
+	IDs        []uint `json:"ids" binding:"required,min=1,max=500"`
+	Action     string `json:"action" binding:"required,oneof=mark_paid mark_unpaid set_category set_tags move_account delete"`
+	CategoryID *uint  `json:"category_id"`
+	TagIDs     []uint `json:"tag_ids"`
+	TagMode    string `json:"tag_mode" binding:"omitempty,oneof=replace add remove"`
+	AccountID  *uint  `json:"account_id"`
+}
+
+func (req *BulkTransactionRequest) ToEntity() *entities.BulkOperation {
+	return &entities.BulkOperation{
+		Action:     entities.BulkAction(req.Action),
+		CategoryID: req.CategoryID,
+		TagIDs:     req.TagIDs,
+		TagMode:    entities.BulkTagMode(req.TagMode),
+		AccountID:  req.AccountID,
+	}
+}
+
+type SuggestCategoryRequest struct {
+	Description string `form:"description" binding:"required,max=255"`
+	Limit       int    `form:"limit" binding:"omitempty,min=1,max=10"`
+}
+
+type SearchTransactionsRequest struct {
+	TransactionFiltersRequest
+	Query string `form:"q" binding:"required,max=200"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=200"`
 }
 
 type TransactionFiltersRequest struct {
-	Paid       *bool   `form:"paid"`
-	Month      *int    `form:"month"`
-	Year       *int    `form:"year"`
-	Type       *string `form:"type"`
-	CategoryID *uint   `form:"category_id"`
+	Paid        *bool     `form:"paid"`
+	Month       *int      `form:"month"`
+	Year        *int      `form:"year"`
+	Type        *string   `form:"type"`
+	CategoryID  *uint     `form:"category_id"`
+	PayeeID     *uint     `form:"payee_id"`
+	AccountID   *uint     `form:"account_id"`
+	TagIDs      []uint    `form:"tag_ids"`
+	TagMatch    string    `form:"tag_match" binding:"omitempty,oneof=any all"`
+	StartDate   time.Time `form:"start_date" time_format:"2006-01-02" time_utc:"1"`
+	EndDate     time.Time `form:"end_date" time_format:"2006-01-02" time_utc:"1"`
+	MinAmount   *float64  `form:"min_amount" binding:"omitempty,gte=0"`
+	MaxAmount   *float64  `form:"max_amount" binding:"omitempty,gte=0"`
+	Description string    `form:"description" binding:"max=255"`
+}
+
+type ListTransactionsRequest struct {
+	TransactionFiltersRequest
+	SortBy string `form:"sort_by" binding:"omitempty,oneof=date amount description created_at"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor string `form:"cursor" binding:"max=512"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=200"`
 }
 
 // Response DTOs
 type TransactionResponse struct {
-	ID             uint                     `json:"id"`
-	Description    string                   `json:"description"`
-	Amount         float64                  `json:"amount"`
-	Type           entities.TransactionType `json:"type"`
-	Date           time.Time                `json:"date"`
-	CategoryID     *uint                    `json:"category_id"`
-	PiggyBankID    *uint                    `json:"piggy_bank_id"`
-	UserID         uint                     `json:"user_id"`
-	ParentID       *uint                    `json:"parent_id"`
-	Paid           bool                     `json:"paid"`
-	IsRecurrent    bool                     `json:"is_recurrent"`
-	RecurrenceType entities.RecurrenceType  `json:"recurrence_type"`
-	RecurrenceEnd  *time.Time               `json:"recurrence_end"`
-	CreatedAt      time.Time                `json:"created_at"`
-	UpdatedAt      time.Time                `json:"updated_at"`
+	ID                  uint                       `json:"id"`
+	Description         string                     `json:"description"`
+	Notes               string                     `json:"notes"`
+	Amount              float64                    `json:"amount"`
+	Type                entities.TransactionType   `json:"type"`
+	Date                time.Time                  `json:"date"`
+	CategoryID          *uint                      `json:"category_id"`
+	PayeeID             *uint                      `json:"payee_id"`
+	PiggyBankID         *uint                      `json:"piggy_bank_id"`
+	AccountID           *uint                      `json:"account_id"`
+	UserID              uint                       `json:"user_id"`
+	ParentID            *uint                      `json:"parent_id"`
+	Paid                bool                       `json:"paid"`
+	IsRecurrent         bool                       `json:"is_recurrent"`
+	RecurrenceType      entities.RecurrenceType    `json:"recurrence_type"`
+	RecurrenceEnd       *time.Time                 `json:"recurrence_end"`
+	InstallmentNumber   int                        `json:"installment_number,omitempty"`
+	InstallmentTotal    int                        `json:"installment_total,omitempty"`
+	TransferPairID      *uint                      `json:"transfer_pair_id,omitempty"`
+	TransferDirection   entities.TransferDirection `json:"transfer_direction,omitempty"`
+	Splits              []TransactionSplitResponse `json:"splits,omitempty"`
+	TagIDs              []uint                     `json:"tag_ids"`
+	CreatedAt           time.Time                  `json:"created_at"`
+	UpdatedAt           time.Time                  `json:"updated_at"`
+	DuplicateCandidates []uint                     `json:"duplicate_candidates,omitempty"`
+	Warning             string                     `json:"warning,omitempty"`
+}
+
+type BulkTransactionResponse struct {
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Results   []BulkItemResponse `json:"results"`
+}
+
+type BulkItemResponse struct {
+	ID      uint   `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+type TransactionPageResponse struct {
+	Data       []TransactionResponse     `json:"data"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+	HasMore    bool                      `json:"has_more"`
+	Totals     TransactionTotalsResponse `json:"totals"`
+}
+
+type TransactionTotalsResponse struct {
+	Count      int64   `json:"count"`
+	Income     float64 `json:"income"`
+	Expense    float64 `json:"expense"`
+	Investment float64 `json:"investment"`
+	Balance    float64 `json:"balance"`
+}
+
+type TransactionSearchResponse struct {
+	TransactionResponse
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+type DuplicateGroupResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+}
+
+type CategorySuggestionResponse struct {
+	CategoryID   uint    `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	Color        string  `json:"color"`
+	Confidence   float64 `json:"confidence"`
+}
+
+type TransactionSplitResponse struct {
+	ID         uint    `json:"id"`
+	CategoryID uint    `json:"category_id"`
+	Amount     float64 `json:"amount"`
+	Memo       string  `json:"memo"`
 }
 
 type TransactionStatsResponse struct {
@@ -81,22 +237,141 @@ type ReportResponse struct {
 // Mappers
 func ToTransactionResponse(transaction *entities.Transaction) TransactionResponse {
 	return TransactionResponse{
-		ID:             transaction.ID,
-		Description:    transaction.Description,
-		Amount:         transaction.Amount,
-		Type:           transaction.Type,
-		Date:           transaction.Date,
-		CategoryID:     transaction.CategoryID,
-		PiggyBankID:    transaction.PiggyBankID,
-		UserID:         transaction.UserID,
-		ParentID:       transaction.ParentID,
-		Paid:           transaction.Paid,
-		IsRecurrent:    transaction.IsRecurrent,
-		RecurrenceType: transaction.RecurrenceType,
-		RecurrenceEnd:  transaction.RecurrenceEnd,
-		CreatedAt:      transaction.CreatedAt,
-		UpdatedAt:      transaction.UpdatedAt,
+		ID:                transaction.ID,
+		Description:       transaction.Description,
+		Notes:             transaction.Notes,
+		Amount:            transaction.Amount,
+		Type:              transaction.Type,
+		Date:              transaction.Date,
+		CategoryID:        transaction.CategoryID,
+		PayeeID:           transaction.PayeeID,
+		PiggyBankID:       transaction.PiggyBankID,
+		AccountID:         transaction.AccountID,
+		UserID:            transaction.UserID,
+		ParentID:          transaction.ParentID,
+		Paid:              transaction.Paid,
+		IsRecurrent:       transaction.IsRecurrent,
+		RecurrenceType:    transaction.RecurrenceType,
+		RecurrenceEnd:     transaction.RecurrenceEnd,
+		InstallmentNumber: transaction.InstallmentNumber,
+		InstallmentTotal:  transaction.InstallmentTotal,
+		TransferPairID:    transaction.TransferPairID,
+		TransferDirection: transaction.TransferDirection,
+		Splits:            ToTransactionSplitResponseList(transaction.Splits),
+		TagIDs:            idsOrEmpty(transaction.TagIDs),
+		CreatedAt:         transaction.CreatedAt,
+		UpdatedAt:         transaction.UpdatedAt,
+	}
+}
+
+// SetDuplicateCandidates sinaliza na resposta as possíveis duplicatas da transação
+func (r *TransactionResponse) SetDuplicateCandidates(candidateIDs []uint) {
+	if len(candidateIDs) == 0 {
+		return
+	}
+	r.DuplicateCandidates = candidateIDs
+	r.Warning = entities.DuplicateWarning(candidateIDs)
+}
+
+func ToDuplicateGroupResponseList(groups []*entities.DuplicateGroup) []DuplicateGroupResponse {
+	result := make([]DuplicateGroupResponse, len(groups))
+	for i, group := range groups {
+		result[i] = DuplicateGroupResponse{
+			Transactions: ToTransactionResponseList(group.Transactions),
+		}
+	}
+	return result
+}
+
+func ToBulkTransactionResponse(results []*entities.BulkItemResult) BulkTransactionResponse {
+	response := BulkTransactionResponse{Results: make([]BulkItemResponse, len(results))}
+	for i, result := range results {
+		item := BulkItemResponse{ID: result.TransactionID, Success: result.Err == nil}
+		if result.Err != nil {
+			response.Failed++
+			// Apenas erros de domínio têm mensagem segura para o cliente
+			item.Error = "Erro interno do servidor"
+			if domainErr, ok := result.Err.(pkgErrors.DomainError); ok {
+				item.Error = domainErr.Message
+			}
+		} else {
+			response.Succeeded++
+		}
+		response.Results[i] = item
+	}
+	return response
+}
+
+func ToTransactionPageResponse(page *repositories.TransactionPage) TransactionPageResponse {
+	response := TransactionPageResponse{
+		Data:    ToTransactionResponseList(page.Transactions),
+		HasMore: page.HasMore,
+	}
+
+	if page.NextCursor != nil {
+		response.NextCursor = EncodeTransactionCursor(page.NextCursor)
+	}
+
+	if page.Totals != nil {
+		response.Totals = TransactionTotalsResponse{
+			Count:      page.Totals.Count,
+			Income:     page.Totals.Income,
+			Expense:    page.Totals.Expense,
+			Investment: page.Totals.Investment,
+			Balance:    page.Totals.Income - page.Totals.Expense,
+		}
+	}
+
+	return response
+}
+
+func ToTransactionSearchResponseList(hits []*entities.TransactionSearchHit) []TransactionSearchResponse {
+	result := make([]TransactionSearchResponse, len(hits))
+	for i, hit := range hits {
+		result[i] = TransactionSearchResponse{
+			TransactionResponse: ToTransactionResponse(hit.Transaction),
+			Rank:                hit.Rank,
+			Snippet:             hit.Snippet,
+		}
+	}
+	return result
+}
+
+func ToCategorySuggestionResponseList(suggestions []*entities.CategorySuggestion) []CategorySuggestionResponse {
+	result := make([]CategorySuggestionResponse, len(suggestions))
+	for i, suggestion := range suggestions {
+		result[i] = CategorySuggestionResponse{
+			CategoryID:   suggestion.Category.ID,
+			CategoryName: suggestion.Category.Name,
+			Color:        suggestion.Category.Color,
+			Confidence:   math.Round(suggestion.Confidence*1000) / 1000,
+		}
+	}
+	return result
+}
+
+func ToTransactionSplitResponseList(splits []entities.TransactionSplit) []TransactionSplitResponse {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	result := make([]TransactionSplitResponse, len(splits))
+	for i, split := range splits {
+		result[i] = TransactionSplitResponse{
+			ID:         split.ID,
+			CategoryID: split.CategoryID,
+			Amount:     split.Amount,
+			Memo:       split.Memo,
+		}
+	}
+	return result
+}
+
+func idsOrEmpty(ids []uint) []uint {
+	if ids == nil {
+		return []uint{}
 	}
+	return ids
 }
 
 func ToTransactionResponseList(transactions []*entities.Transaction) []TransactionResponse {
@@ -109,40 +384,176 @@ func ToTransactionResponseList(transactions []*entities.Transaction) []Transacti
 
 func (req *CreateTransactionRequest) ToEntity(userID uint) *entities.Transaction {
 	transaction := entities.NewTransaction(req.Description, req.Amount, req.Type, req.Date, userID)
+	transaction.Notes = req.Notes
 
 	if req.CategoryID != nil {
 		transaction.SetCategory(*req.CategoryID)
 	}
 
+	if req.PayeeID != nil {
+		transaction.SetPayee(*req.PayeeID)
+	}
+
 	if req.PiggyBankID != nil {
 		transaction.SetPiggyBank(*req.PiggyBankID)
 	}
 
+	if req.AccountID != nil {
+		transaction.SetAccount(*req.AccountID)
+	}
+
 	if req.IsRecurrent {
 		transaction.SetRecurrence(req.RecurrenceType, req.RecurrenceEnd)
 	}
 
+	if len(req.Splits) > 0 {
+		transaction.SetSplits(toSplitEntities(req.Splits))
+	}
+
+	if len(req.TagIDs) > 0 {
+		transaction.SetTags(req.TagIDs)
+	}
+
 	transaction.Paid = req.Paid
 
 	return transaction
 }
 
+// IsInstallmentPurchase indica se a requisição descreve uma compra parcelada
+func (req *CreateTransactionRequest) IsInstallmentPurchase() bool {
+	return req.Installments > 1
+}
+
+func (req *CreateTransferRequest) ToEntity(userID uint) *entities.Transaction {
+	transaction := entities.NewTransaction(req.Description, req.Amount, entities.TRANSFER, req.Date, userID)
+	transaction.Paid = req.Paid
+	return transaction
+}
+
+func (req *UpdateInstallmentsRequest) ToEntity(userID uint) *entities.Transaction {
+	transaction := &entities.Transaction{
+		Description: req.Description,
+		Amount:      req.Amount,
+		UserID:      userID,
+	}
+
+	if req.CategoryID != nil {
+		transaction.SetCategory(*req.CategoryID)
+	}
+
+	return transaction
+}
+
 func (req *UpdateTransactionRequest) ToEntity(userID uint) *entities.Transaction {
 	transaction := entities.NewTransaction(req.Description, req.Amount, req.Type, req.Date, userID)
+	transaction.Notes = req.Notes
 
 	if req.CategoryID != nil {
 		transaction.SetCategory(*req.CategoryID)
 	}
 
+	if req.PayeeID != nil {
+		transaction.SetPayee(*req.PayeeID)
+	}
+
 	if req.PiggyBankID != nil {
 		transaction.SetPiggyBank(*req.PiggyBankID)
 	}
 
+	if req.AccountID != nil {
+		transaction.SetAccount(*req.AccountID)
+	}
+
 	if req.IsRecurrent {
 		transaction.SetRecurrence(req.RecurrenceType, req.RecurrenceEnd)
 	}
 
+	// Lista vazia remove a divisão; campo ausente mantém a atual
+	if req.Splits != nil {
+		transaction.SetSplits(toSplitEntities(*req.Splits))
+	}
+
+	if req.TagIDs != nil {
+		transaction.SetTags(*req.TagIDs)
+	}
+
 	transaction.Paid = req.Paid
 
 	return transaction
 }
+
+func toSplitEntities(splits []TransactionSplitRequest) []entities.TransactionSplit {
+	result := make([]entities.TransactionSplit, len(splits))
+	for i, split := range splits {
+		result[i] = entities.NewTransactionSplit(split.CategoryID, split.Amount, split.Memo)
+	}
+	return result
+}
+
+// ToRepositoryFilters converte os filtros da query string para os filtros do repositório
+func (req *TransactionFiltersRequest) ToRepositoryFilters(userID uint) *repositories.TransactionFilters {
+	filters := &repositories.TransactionFilters{
+		UserID:      userID,
+		Paid:        req.Paid,
+		Month:       req.Month,
+		Year:        req.Year,
+		Type:        req.Type,
+		CategoryID:  req.CategoryID,
+		PayeeID:     req.PayeeID,
+		AccountID:   req.AccountID,
+		TagIDs:      req.TagIDs,
+		TagMatch:    repositories.TagMatchMode(req.TagMatch),
+		StartDate:   req.StartDate,
+		MinAmount:   req.MinAmount,
+		MaxAmount:   req.MaxAmount,
+		Description: strings.TrimSpace(req.Description),
+	}
+
+	// A data final inclui o dia inteiro
+	if !req.EndDate.IsZero() {
+		filters.EndDate = req.EndDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	return filters
+}
+
+// ToPageRequest converte ordenação e cursor da requisição; sem ordem informada,
+// a listagem começa pelas transações mais recentes
+func (req *ListTransactionsRequest) ToPageRequest() (*repositories.TransactionPageRequest, error) {
+	page := &repositories.TransactionPageRequest{
+		SortBy:     repositories.TransactionSortField(req.SortBy),
+		Descending: req.Order != "asc",
+		Limit:      req.Limit,
+	}
+
+	if req.Cursor != "" {
+		cursor, err := DecodeTransactionCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		page.After = cursor
+	}
+
+	return page, nil
+}
+
+// EncodeTransactionCursor gera o cursor opaco enviado ao cliente
+func EncodeTransactionCursor(cursor *repositories.TransactionCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeTransactionCursor lê um cursor gerado por EncodeTransactionCursor
+func DecodeTransactionCursor(value string) (*repositories.TransactionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	var cursor repositories.TransactionCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return nil, errInvalidCursor
+	}
+
+	return &cursor, nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
 	pkgErrors "my-finance-hub-api/pkg/errors"
 
 	"github.com/gin-gonic/gin"
@@ -15,11 +16,13 @@ import (
 
 type AuthMiddleware struct {
 	authService interfaces.AuthService
+	adminEmails []string
 }
 
-func NewAuthMiddleware(authService interfaces.AuthService) *AuthMiddleware {
+func NewAuthMiddleware(authService interfaces.AuthService, adminEmails []string) *AuthMiddleware {
 	return &AuthMiddleware{
 		authService: authService,
+		adminEmails: adminEmails,
 	}
 }
 
@@ -75,6 +78,32 @@ func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 	}
 }
 
+// RequireAdmin restringe a rota aos usuários listados em ADMIN_EMAILS; deve ser
+// usado depois de RequireAuth. Sem administradores configurados, ninguém tem acesso.
+func (m *AuthMiddleware) RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		user, ok := c.Get("user")
+		if ok {
+			if authUser, isUser := user.(*entities.User); isUser {
+				for _, email := range m.adminEmails {
+					if strings.EqualFold(email, authUser.Email) {
+						c.Next()
+						return
+					}
+				}
+			}
+		}
+
+		c.JSON(http.StatusForbidden, gin.H{"error": "Acesso restrito a administradores"})
+		c.Abort()
+	}
+}
+
 func (m *AuthMiddleware) validateToken(tokenString string) (uint, error) {
 	// Parse do token
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
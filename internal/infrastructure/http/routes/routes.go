@@ -48,6 +48,19 @@ func setupProtectedRoutes(group *gin.RouterGroup, container *container.Container
 		categories.DELETE("/:id", container.CategoryController.DeleteCategory)
 	}
 
+	// Tags routes
+	tags := group.Group("/tags")
+	{
+		tags.GET("/", container.TagController.GetTags)
+		tags.GET("", container.TagController.GetTags)
+		tags.POST("/", container.TagController.CreateTag)
+		tags.POST("", container.TagController.CreateTag)
+		tags.GET("/:id", container.TagController.GetTag)
+		tags.PUT("/:id", container.TagController.UpdateTag)
+		tags.PATCH("/:id", container.TagController.UpdateTag)
+		tags.DELETE("/:id", container.TagController.DeleteTag)
+	}
+
 	// Goals routes
 	goals := group.Group("/goals")
 	{
@@ -55,10 +68,12 @@ func setupProtectedRoutes(group *gin.RouterGroup, container *container.Container
 		goals.GET("", container.GoalController.GetGoals)
 		goals.POST("/", container.GoalController.CreateGoal)
 		goals.POST("", container.GoalController.CreateGoal)
+		goals.GET("/progress", container.GoalController.GetGoalsProgress)
 		goals.GET("/:id", container.GoalController.GetGoal)
 		goals.PUT("/:id", container.GoalController.UpdateGoal)
 		goals.PATCH("/:id", container.GoalController.UpdateGoal)
 		goals.DELETE("/:id", container.GoalController.DeleteGoal)
+		goals.GET("/:id/progress", container.GoalController.GetGoalProgress)
 	}
 
 	// Saving Goals (PiggyBanks) routes
@@ -73,6 +88,113 @@ func setupProtectedRoutes(group *gin.RouterGroup, container *container.Container
 		savingGoals.PATCH("/:id", container.SavingGoalController.UpdateSavingGoal)
 		savingGoals.DELETE("/:id", container.SavingGoalController.DeleteSavingGoal)
 		savingGoals.POST("/:id/deposit", container.SavingGoalController.Deposit)
+		savingGoals.POST("/:id/withdraw", container.SavingGoalController.Withdraw)
+		savingGoals.GET("/:id/movements", container.SavingGoalController.GetMovements)
+		savingGoals.GET("/:id/history", container.SavingGoalController.GetHistory)
+	}
+
+	// Accounts (wallets) routes
+	accounts := group.Group("/accounts")
+	{
+		accounts.GET("/", container.AccountController.GetAccounts)
+		accounts.GET("", container.AccountController.GetAccounts)
+		accounts.POST("/", container.AccountController.CreateAccount)
+		accounts.POST("", container.AccountController.CreateAccount)
+		accounts.GET("/balances", container.AccountController.GetBalances)
+		accounts.GET("/:id", container.AccountController.GetAccount)
+		accounts.PUT("/:id", container.AccountController.UpdateAccount)
+		accounts.PATCH("/:id", container.AccountController.UpdateAccount)
+		accounts.DELETE("/:id", container.AccountController.DeleteAccount)
+		accounts.PATCH("/:id/archive", container.AccountController.ArchiveAccount)
+		accounts.GET("/:id/invoices", container.InvoiceController.GetAccountInvoices)
+	}
+
+	// Credit card invoices routes
+	invoices := group.Group("/invoices")
+	{
+		invoices.GET("/:id", container.InvoiceController.GetInvoice)
+		invoices.POST("/:id/pay", container.InvoiceController.PayInvoice)
+	}
+
+	// CSV import profiles routes
+	importProfiles := group.Group("/import-profiles")
+	{
+		importProfiles.GET("/", container.ImportController.GetProfiles)
+		importProfiles.GET("", container.ImportController.GetProfiles)
+		importProfiles.POST("/", container.ImportController.CreateProfile)
+		importProfiles.POST("", container.ImportController.CreateProfile)
+		importProfiles.GET("/:id", container.ImportController.GetProfile)
+		importProfiles.PUT("/:id", container.ImportController.UpdateProfile)
+		importProfiles.PATCH("/:id", container.ImportController.UpdateProfile)
+		importProfiles.DELETE("/:id", container.ImportController.DeleteProfile)
+	}
+
+	// Payees routes
+	payees := group.Group("/payees")
+	{
+		payees.GET("/", container.PayeeController.GetPayees)
+		payees.GET("", container.PayeeController.GetPayees)
+		payees.POST("/", container.PayeeController.CreatePayee)
+		payees.POST("", container.PayeeController.CreatePayee)
+		payees.GET("/:id", container.PayeeController.GetPayee)
+		payees.PUT("/:id", container.PayeeController.UpdatePayee)
+		payees.PATCH("/:id", container.PayeeController.UpdatePayee)
+		payees.DELETE("/:id", container.PayeeController.DeletePayee)
+	}
+
+	// Budgets routes
+	budgets := group.Group("/budgets")
+	{
+		budgets.GET("/", container.BudgetController.GetBudgets)
+		budgets.GET("", container.BudgetController.GetBudgets)
+		budgets.POST("/", container.BudgetController.CreateBudget)
+		budgets.POST("", container.BudgetController.CreateBudget)
+		budgets.GET("/status", container.BudgetController.GetBudgetStatus)
+		budgets.GET("/:id", container.BudgetController.GetBudget)
+		budgets.PUT("/:id", container.BudgetController.UpdateBudget)
+		budgets.PATCH("/:id", container.BudgetController.UpdateBudget)
+		budgets.DELETE("/:id", container.BudgetController.DeleteBudget)
+	}
+
+	// Notifications routes
+	notifications := group.Group("/notifications")
+	{
+		notifications.GET("/", container.NotificationController.GetNotifications)
+		notifications.GET("", container.NotificationController.GetNotifications)
+		notifications.POST("/read-all", container.NotificationController.MarkAllAsRead)
+		notifications.GET("/settings", container.NotificationController.GetSettings)
+		notifications.PUT("/settings", container.NotificationController.UpdateSettings)
+		notifications.POST("/:id/read", container.NotificationController.MarkAsRead)
+	}
+
+	// Envelope budgeting routes
+	envelopes := group.Group("/envelopes")
+	{
+		envelopes.GET("/", container.EnvelopeController.GetEnvelopeSettings)
+		envelopes.GET("", container.EnvelopeController.GetEnvelopeSettings)
+		envelopes.POST("/", container.EnvelopeController.EnableEnvelopes)
+		envelopes.POST("", container.EnvelopeController.EnableEnvelopes)
+		envelopes.DELETE("/", container.EnvelopeController.DisableEnvelopes)
+		envelopes.DELETE("", container.EnvelopeController.DisableEnvelopes)
+		envelopes.GET("/months/:month", container.EnvelopeController.GetEnvelopeMonth)
+		envelopes.POST("/assign", container.EnvelopeController.AssignToEnvelope)
+		envelopes.POST("/move", container.EnvelopeController.MoveBetweenEnvelopes)
+		envelopes.GET("/ledger", container.EnvelopeController.GetLedger)
+	}
+
+	// Auto-categorization rules routes
+	rules := group.Group("/rules")
+	{
+		rules.GET("/", container.RuleController.GetRules)
+		rules.GET("", container.RuleController.GetRules)
+		rules.POST("/", container.RuleController.CreateRule)
+		rules.POST("", container.RuleController.CreateRule)
+		rules.GET("/:id", container.RuleController.GetRule)
+		rules.PUT("/:id", container.RuleController.UpdateRule)
+		rules.PATCH("/:id", container.RuleController.UpdateRule)
+		rules.DELETE("/:id", container.RuleController.DeleteRule)
+		rules.GET("/:id/preview", container.RuleController.PreviewRule)
+		rules.POST("/:id/apply", container.RuleController.ApplyRule)
 	}
 
 	// Transactions routes
@@ -87,11 +209,39 @@ func setupProtectedRoutes(group *gin.RouterGroup, container *container.Container
 		transactions.PATCH("/:id", container.TransactionController.UpdateTransaction)
 		transactions.DELETE("/:id", container.TransactionController.DeleteTransaction)
 		transactions.PATCH("/:id/paid", container.TransactionController.TogglePaid)
+		transactions.POST("/transfers", container.TransactionController.CreateTransfer)
+		transactions.GET("/duplicates", container.TransactionController.GetDuplicates)
+		transactions.POST("/duplicates/merge", container.TransactionController.MergeDuplicates)
+		transactions.GET("/suggest-category", container.TransactionController.SuggestCategory)
+		transactions.GET("/search", container.TransactionController.SearchTransactions)
+		transactions.POST("/bulk", container.TransactionController.BulkUpdateTransactions)
+		transactions.GET("/:id/installments", container.TransactionController.GetInstallments)
+		transactions.PUT("/:id/installments", container.TransactionController.UpdateInstallments)
+		transactions.DELETE("/:id/installments", container.TransactionController.CancelInstallments)
+		transactions.GET("/:id/attachments", container.TransactionController.GetAttachments)
+		transactions.POST("/:id/attachments", container.TransactionController.UploadAttachment)
+		transactions.GET("/:id/attachments/:attachmentId", container.TransactionController.DownloadAttachment)
+		transactions.GET("/:id/attachments/:attachmentId/thumbnail", container.TransactionController.DownloadAttachmentThumbnail)
+		transactions.DELETE("/:id/attachments/:attachmentId", container.TransactionController.DeleteAttachment)
+		transactions.POST("/recurring/generate", container.TransactionController.GenerateRecurring)
+		transactions.POST("/import/ofx", container.ImportController.ImportOFX)
+		transactions.POST("/import/csv", container.ImportController.ImportCSV)
+		transactions.GET("/export", container.ExportController.ExportTransactions)
 		// Endpoint específico para relatórios do dashboard
 		transactions.GET("/reports", container.TransactionController.GetDashboardReports)
 		transactions.GET("/reports/", container.TransactionController.GetDashboardReports)
 	}
 
+	// Scheduled jobs routes (apenas administradores)
+	jobs := group.Group("/jobs")
+	jobs.Use(container.AuthMiddleware.RequireAdmin())
+	{
+		jobs.GET("/", container.JobController.GetJobs)
+		jobs.GET("", container.JobController.GetJobs)
+		jobs.GET("/:name/runs", container.JobController.GetJobRuns)
+		jobs.POST("/:name/run", container.JobController.TriggerJob)
+	}
+
 	// Reports routes
 	reports := group.Group("/reports")
 	{
@@ -0,0 +1,174 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule representa uma expressão cron de cinco campos
+// (minuto, hora, dia do mês, mês, dia da semana)
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+type fieldBounds struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteBounds = fieldBounds{"minuto", 0, 59}
+	hourBounds   = fieldBounds{"hora", 0, 23}
+	domBounds    = fieldBounds{"dia do mês", 1, 31}
+	monthBounds  = fieldBounds{"mês", 1, 12}
+	dowBounds    = fieldBounds{"dia da semana", 0, 7}
+)
+
+// Atalhos aceitos no lugar da expressão completa
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseSchedule interpreta uma expressão cron. Cada campo aceita "*", valores,
+// intervalos ("1-5"), listas ("1,15") e passos ("*/15", "0-30/10").
+func ParseSchedule(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := descriptors[spec]; ok {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expressão cron deve ter 5 campos: %q", spec)
+	}
+
+	schedule := &Schedule{
+		domStar: fields[2] == "*" || fields[2] == "?",
+		dowStar: fields[4] == "*" || fields[4] == "?",
+	}
+
+	var err error
+	if schedule.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, err
+	}
+	if schedule.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, err
+	}
+	if schedule.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, err
+	}
+	if schedule.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, err
+	}
+	if schedule.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, err
+	}
+
+	// Domingo pode ser informado como 0 ou 7
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1
+	}
+
+	return schedule, nil
+}
+
+func parseField(field string, bounds fieldBounds) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			var err error
+			rangePart = part[:idx]
+			step, err = strconv.Atoi(part[idx+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("passo inválido no campo %s: %q", bounds.name, part)
+			}
+		}
+
+		start, end := bounds.min, bounds.max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			limits := strings.SplitN(rangePart, "-", 2)
+			var err1, err2 error
+			start, err1 = strconv.Atoi(limits[0])
+			end, err2 = strconv.Atoi(limits[1])
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("intervalo inválido no campo %s: %q", bounds.name, part)
+			}
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("valor inválido no campo %s: %q", bounds.name, part)
+			}
+			start = value
+			// "5/10" significa a partir de 5 até o máximo
+			end = value
+			if step > 1 {
+				end = bounds.max
+			}
+		}
+
+		if start < bounds.min || end > bounds.max || start > end {
+			return 0, fmt.Errorf("valor fora do intervalo no campo %s: %q", bounds.name, part)
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+// Next retorna o próximo instante, estritamente posterior a t, que satisfaz a expressão
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Limite de busca para expressões impossíveis (ex.: 31 de fevereiro)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches segue a semântica do cron: quando dia do mês e dia da semana são
+// restritos, basta que um deles corresponda
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"5-1 * * * *",
+		"a-5 * * * *",
+		"abc * * * *",
+		"@every",
+	} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("ParseSchedule(%q) deveria falhar", spec)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{name: "a cada minuto", spec: "* * * * *", from: at(2026, 10, 16, 10, 0).Add(30 * time.Second), want: at(2026, 10, 16, 10, 1)},
+		{name: "estritamente posterior", spec: "0 10 * * *", from: at(2026, 10, 16, 10, 0), want: at(2026, 10, 17, 10, 0)},
+		{name: "passo de 15 minutos", spec: "*/15 * * * *", from: at(2026, 10, 16, 10, 16), want: at(2026, 10, 16, 10, 30)},
+		{name: "intervalo com passo", spec: "0-30/10 * * * *", from: at(2026, 10, 16, 10, 31), want: at(2026, 10, 16, 11, 0)},
+		{name: "valor com passo", spec: "50/5 * * * *", from: at(2026, 10, 16, 10, 51), want: at(2026, 10, 16, 10, 55)},
+		{name: "lista de horas", spec: "0 8,20 * * *", from: at(2026, 10, 16, 9, 0), want: at(2026, 10, 16, 20, 0)},
+		{name: "dias úteis", spec: "0 9 * * 1-5", from: at(2026, 10, 16, 10, 0), want: at(2026, 10, 19, 9, 0)},
+		{name: "domingo como 7", spec: "0 0 * * 7", from: at(2026, 10, 16, 10, 0), want: at(2026, 10, 18, 0, 0)},
+		{name: "dia do mês ou da semana", spec: "0 0 1 * 6", from: at(2026, 10, 16, 10, 0), want: at(2026, 10, 17, 0, 0)},
+		{name: "virada de ano", spec: "@yearly", from: at(2026, 10, 16, 10, 0), want: at(2027, 1, 1, 0, 0)},
+		{name: "mensal", spec: "@monthly", from: at(2026, 12, 31, 23, 59), want: at(2027, 1, 1, 0, 0)},
+		{name: "semanal", spec: "@weekly", from: at(2026, 10, 16, 10, 0), want: at(2026, 10, 18, 0, 0)},
+		{name: "de hora em hora", spec: "@hourly", from: at(2026, 10, 16, 10, 0), want: at(2026, 10, 16, 11, 0)},
+		{name: "29 de fevereiro", spec: "0 0 29 2 *", from: at(2026, 10, 16, 10, 0), want: at(2028, 2, 29, 0, 0)},
+		{name: "data impossível", spec: "0 0 31 2 *", from: at(2026, 10, 16, 10, 0), want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseSchedule(%q) retornou erro: %v", tt.spec, err)
+			}
+			if got := schedule.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, esperava %s", tt.from, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"my-finance-hub-api/internal/application/interfaces"
+	"my-finance-hub-api/internal/domain/entities"
+	"my-finance-hub-api/internal/domain/repositories"
+	pkgErrors "my-finance-hub-api/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrJobNotFound       = pkgErrors.NewDomainError("not_found", "Tarefa agendada não encontrada")
+	ErrJobAlreadyRunning = pkgErrors.NewDomainError("conflict", "Tarefa já está em execução")
+)
+
+type job struct {
+	name     string
+	spec     string
+	schedule *Schedule
+	run      interfaces.JobFunc
+}
+
+// Scheduler executa tarefas periódicas dentro do próprio processo. Cada execução
+// obtém um advisory lock do Postgres, de modo que apenas uma réplica execute a
+// mesma tarefa ao mesmo tempo.
+type Scheduler struct {
+	db         *gorm.DB
+	jobRunRepo repositories.JobRunRepository
+
+	mu      sync.RWMutex
+	jobs    map[string]*job
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+}
+
+func NewScheduler(db *gorm.DB, jobRunRepo repositories.JobRunRepository) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		db:         db,
+		jobRunRepo: jobRunRepo,
+		jobs:       make(map[string]*job),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+func (s *Scheduler) Register(name, spec string, run interfaces.JobFunc) error {
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return fmt.Errorf("tarefa %s: %w", name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[name]; exists {
+		return fmt.Errorf("tarefa %s já registrada", name)
+	}
+
+	s.jobs[name] = &job{
+		name:     name,
+		spec:     spec,
+		schedule: schedule,
+		run:      run,
+	}
+
+	return nil
+}
+
+func (s *Scheduler) ListJobs(ctx context.Context) ([]*entities.ScheduledJob, error) {
+	s.mu.RLock()
+	jobs := make([]*job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(jobs, func(i, k int) bool { return jobs[i].name < jobs[k].name })
+
+	now := time.Now()
+	result := make([]*entities.ScheduledJob, len(jobs))
+	for i, j := range jobs {
+		scheduled := &entities.ScheduledJob{
+			Name:    j.name,
+			Spec:    j.spec,
+			NextRun: j.schedule.Next(now),
+		}
+
+		runs, err := s.jobRunRepo.GetRecentByJob(ctx, j.name, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) > 0 {
+			scheduled.LastRun = runs[0]
+		}
+
+		result[i] = scheduled
+	}
+
+	return result, nil
+}
+
+func (s *Scheduler) GetJobRuns(ctx context.Context, name string, limit int) ([]*entities.JobRun, error) {
+	if _, err := s.getJob(name); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	return s.jobRunRepo.GetRecentByJob(ctx, name, limit)
+}
+
+// TriggerJob registra uma execução na fila e a executa em segundo plano, fora do
+// contexto da requisição; o resultado fica no histórico de execuções
+func (s *Scheduler) TriggerJob(ctx context.Context, name string) (*entities.JobRun, error) {
+	j, err := s.getJob(name)
+	if err != nil {
+		return nil, err
+	}
+
+	run := entities.NewQueuedJobRun(j.name, entities.JobTriggerManual)
+	if err := s.jobRunRepo.Create(ctx, run); err != nil {
+		return nil, err
+	}
+
+	// Copiar a execução para não compartilhar a entidade retornada com a goroutine
+	queued := *run
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if _, _, err := s.execute(s.ctx, j, entities.JobTriggerManual, &queued); err != nil {
+			log.Printf("Erro ao executar tarefa %s: %v", j.name, err)
+		}
+	}()
+
+	return run, nil
+}
+
+// Start inicia um laço por tarefa registrada; as execuções param quando ctx é
+// cancelado ou Stop é chamado, e o agendador não pode ser reiniciado depois disso
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return
+	}
+
+	s.running = true
+	// Encerrar as tarefas também quando o contexto recebido for cancelado
+	context.AfterFunc(ctx, s.cancel)
+
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(s.ctx, j)
+	}
+
+	log.Printf("⏰ Agendador iniciado com %d tarefa(s)", len(s.jobs))
+}
+
+// Stop cancela as tarefas, inclusive as execuções manuais, e aguarda o término delas
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	s.cancel()
+	s.running = false
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	log.Println("Agendador finalizado")
+}
+
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	defer s.wg.Done()
+
+	for {
+		next := j.schedule.Next(time.Now())
+		if next.IsZero() {
+			log.Printf("Tarefa %s sem próxima execução para a expressão %q", j.name, j.spec)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if _, acquired, err := s.execute(ctx, j, entities.JobTriggerScheduled, nil); err != nil {
+			log.Printf("Erro ao executar tarefa %s: %v", j.name, err)
+		} else if !acquired {
+			log.Printf("Tarefa %s ignorada: em execução em outra instância", j.name)
+		}
+	}
+}
+
+// execute roda a tarefa segurando um advisory lock de sessão na mesma conexão,
+// registrando a execução no histórico. Retorna acquired=false quando o lock já está
+// em uso; nesse caso a execução na fila (queued), se houver, é registrada como falha.
+func (s *Scheduler) execute(ctx context.Context, j *job, trigger entities.JobTrigger, queued *entities.JobRun) (*entities.JobRun, bool, error) {
+	run := queued
+	acquired := false
+
+	err := s.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?)", lockKey(j.name)).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			if run != nil {
+				run.Finish(ErrJobAlreadyRunning)
+				return s.jobRunRepo.Update(context.Background(), run)
+			}
+			return nil
+		}
+		defer func() {
+			// Usar contexto próprio para liberar o lock mesmo se ctx tiver sido cancelado
+			if err := conn.WithContext(context.Background()).Exec("SELECT pg_advisory_unlock(?)", lockKey(j.name)).Error; err != nil {
+				log.Printf("Erro ao liberar lock da tarefa %s: %v", j.name, err)
+			}
+		}()
+
+		if run != nil {
+			run.Start()
+			if err := s.jobRunRepo.Update(ctx, run); err != nil {
+				return err
+			}
+		} else {
+			run = entities.NewJobRun(j.name, trigger)
+			if err := s.jobRunRepo.Create(ctx, run); err != nil {
+				return err
+			}
+		}
+
+		run.Finish(runSafely(ctx, j))
+
+		if err := s.jobRunRepo.Update(context.Background(), run); err != nil {
+			return err
+		}
+
+		log.Printf("Tarefa %s finalizada com status %s em %s", j.name, run.Status, run.Duration())
+		return nil
+	})
+
+	return run, acquired, err
+}
+
+func (s *Scheduler) getJob(name string) (*job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	j, ok := s.jobs[name]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	return j, nil
+}
+
+// runSafely converte um panic da tarefa em erro para não derrubar o servidor
+func runSafely(ctx context.Context, j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return j.run(ctx)
+}
+
+// lockKey deriva a chave do advisory lock a partir do nome da tarefa
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("scheduler:" + name))
+	return int64(h.Sum64())
+}
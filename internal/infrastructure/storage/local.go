@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pkgErrors "my-finance-hub-api/pkg/errors"
+)
+
+// LocalStorage guarda os arquivos em um diretório do sistema de arquivos local
+type LocalStorage struct {
+	baseDir string
+}
+
+func NewLocalStorage(baseDir string) (*LocalStorage, error) {
+	absDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(absDir, 0o750); err != nil {
+		return nil, fmt.Errorf("erro ao criar diretório de armazenamento: %w", err)
+	}
+
+	return &LocalStorage{baseDir: absDir}, nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	path, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	// Gravar em arquivo temporário e renomear, para não expor arquivos incompletos
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, pkgErrors.ErrFileNotFound
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// resolve converte a chave em caminho absoluto, impedindo acesso fora do diretório base
+func (s *LocalStorage) resolve(key string) (string, error) {
+	path := filepath.Join(s.baseDir, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(path, s.baseDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("chave de armazenamento inválida: %q", key)
+	}
+	return path, nil
+}
@@ -0,0 +1,93 @@
+// Package charset converte arquivos texto exportados por bancos para UTF-8.
+package charset
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	Auto   = "auto"
+	UTF8   = "utf-8"
+	Latin1 = "iso-8859-1"
+)
+
+// IsSupported verifica se a codificação informada é aceita por ToUTF8
+func IsSupported(encoding string) bool {
+	switch strings.ToLower(encoding) {
+	case "", Auto, UTF8, Latin1, "latin1", "windows-1252":
+		return true
+	default:
+		return false
+	}
+}
+
+// ToUTF8 decodifica o conteúdo conforme a codificação. Em "auto" o conteúdo é
+// tratado como UTF-8 quando válido e como Windows-1252 caso contrário.
+// ISO-8859-1 é lido como Windows-1252, que o estende na faixa 0x80-0x9F.
+func ToUTF8(data []byte, encoding string) string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	switch strings.ToLower(encoding) {
+	case UTF8:
+		return string(data)
+	case Latin1, "latin1", "windows-1252":
+		return decodeWindows1252(data)
+	default:
+		if utf8.Valid(data) {
+			return string(data)
+		}
+		return decodeWindows1252(data)
+	}
+}
+
+// FromUTF8 codifica o texto na codificação informada. Em ISO-8859-1/Windows-1252,
+// caracteres sem representação são trocados por "?".
+func FromUTF8(text, encoding string) []byte {
+	switch strings.ToLower(encoding) {
+	case Latin1, "latin1", "windows-1252":
+		return encodeWindows1252(text)
+	default:
+		return []byte(text)
+	}
+}
+
+func encodeWindows1252(text string) []byte {
+	data := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r < 0x80 || (r >= 0xa0 && r <= 0xff):
+			data = append(data, byte(r))
+		default:
+			b := byte('?')
+			for i, special := range windows1252 {
+				if special == r {
+					b = byte(0x80 + i)
+					break
+				}
+			}
+			data = append(data, b)
+		}
+	}
+	return data
+}
+
+func decodeWindows1252(data []byte) string {
+	var builder strings.Builder
+	builder.Grow(len(data) + len(data)/10)
+	for _, b := range data {
+		if b >= 0x80 && b <= 0x9f {
+			builder.WriteRune(windows1252[b-0x80])
+		} else {
+			builder.WriteRune(rune(b))
+		}
+	}
+	return builder.String()
+}
+
+// windows1252 mapeia a faixa 0x80-0x9F, onde o Windows-1252 difere do ISO-8859-1
+var windows1252 = [32]rune{
+	'€', '\u0081', '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', '\u008d', 'Ž', '\u008f',
+	'\u0090', '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', '\u009d', 'ž', 'Ÿ',
+}
@@ -0,0 +1,76 @@
+package charset
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestToUTF8(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		encoding string
+		want     string
+	}{
+		{name: "UTF-8 automático", data: []byte("Padaria São João"), encoding: Auto, want: "Padaria São João"},
+		{name: "remove BOM", data: []byte("\xef\xbb\xbfAção"), encoding: Auto, want: "Ação"},
+		{name: "Windows-1252 automático", data: []byte("Padaria S\xe3o Jo\xe3o"), encoding: Auto, want: "Padaria São João"},
+		{name: "faixa especial do Windows-1252", data: []byte("\x80 10 \x96 \x93ok\x94"), encoding: "windows-1252", want: "€ 10 – “ok”"},
+		{name: "ISO-8859-1 explícito", data: []byte("caf\xe9"), encoding: "ISO-8859-1", want: "café"},
+		{name: "Latin1 força decodificação de UTF-8 válido", data: []byte("é"), encoding: "latin1", want: "Ã©"},
+		{name: "UTF-8 explícito", data: []byte("ação"), encoding: UTF8, want: "ação"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToUTF8(tt.data, tt.encoding); got != tt.want {
+				t.Errorf("ToUTF8(%q, %q) = %q, esperava %q", tt.data, tt.encoding, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromUTF8(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		encoding string
+		want     []byte
+	}{
+		{name: "acentos", text: "São João", encoding: Latin1, want: []byte("S\xe3o Jo\xe3o")},
+		{name: "faixa especial", text: "€ – “ok”", encoding: "windows-1252", want: []byte("\x80 \x96 \x93ok\x94")},
+		{name: "sem representação", text: "日本", encoding: Latin1, want: []byte("??")},
+		{name: "UTF-8", text: "ação", encoding: UTF8, want: []byte("ação")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromUTF8(tt.text, tt.encoding)
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("FromUTF8(%q, %q) = %q, esperava %q", tt.text, tt.encoding, got, tt.want)
+			}
+			if tt.encoding != UTF8 && bytes.IndexByte(tt.want, '?') < 0 {
+				if back := ToUTF8(got, tt.encoding); back != tt.text {
+					t.Errorf("ida e volta de %q resultou em %q", tt.text, back)
+				}
+			}
+		})
+	}
+}
+
+func TestIsSupported(t *testing.T) {
+	for encoding, want := range map[string]bool{
+		"":             true,
+		"auto":         true,
+		"UTF-8":        true,
+		"ISO-8859-1":   true,
+		"Windows-1252": true,
+		"latin1":       true,
+		"utf-16":       false,
+		"ascii":        false,
+	} {
+		if got := IsSupported(encoding); got != want {
+			t.Errorf("IsSupported(%q) = %v, esperava %v", encoding, got, want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Package classifier implementa um classificador Naive Bayes multinomial que
+// aprende e esquece exemplos de forma incremental, sem precisar retreinar do zero.
+package classifier
+
+import (
+	"math"
+	"sort"
+)
+
+// Prediction é a probabilidade estimada de uma classe para um documento
+type Prediction struct {
+	Class       uint
+	Probability float64
+}
+
+// NaiveBayes guarda apenas contagens, de modo que Learn e Forget custam O(tokens).
+// Não é seguro para uso concorrente.
+type NaiveBayes struct {
+	documents   int
+	classDocs   map[uint]int
+	classTokens map[uint]int
+	tokenCounts map[uint]map[string]int
+	vocabulary  map[string]int
+}
+
+func NewNaiveBayes() *NaiveBayes {
+	return &NaiveBayes{
+		classDocs:   make(map[uint]int),
+		classTokens: make(map[uint]int),
+		tokenCounts: make(map[uint]map[string]int),
+		vocabulary:  make(map[string]int),
+	}
+}
+
+// Documents retorna a quantidade de exemplos aprendidos
+func (nb *NaiveBayes) Documents() int {
+	return nb.documents
+}
+
+// Learn acrescenta um exemplo da classe ao modelo
+func (nb *NaiveBayes) Learn(class uint, tokens []string) {
+	if len(tokens) == 0 {
+		return
+	}
+
+	counts, ok := nb.tokenCounts[class]
+	if !ok {
+		counts = make(map[string]int)
+		nb.tokenCounts[class] = counts
+	}
+
+	nb.documents++
+	nb.classDocs[class]++
+	for _, token := range tokens {
+		counts[token]++
+		nb.classTokens[class]++
+		nb.vocabulary[token]++
+	}
+}
+
+// Forget remove um exemplo aprendido anteriormente com os mesmos tokens
+func (nb *NaiveBayes) Forget(class uint, tokens []string) {
+	counts, ok := nb.tokenCounts[class]
+	if !ok || len(tokens) == 0 {
+		return
+	}
+
+	nb.documents--
+	nb.classDocs[class]--
+	for _, token := range tokens {
+		if counts[token] == 0 {
+			continue
+		}
+		counts[token]--
+		nb.classTokens[class]--
+		if counts[token] == 0 {
+			delete(counts, token)
+		}
+
+		nb.vocabulary[token]--
+		if nb.vocabulary[token] <= 0 {
+			delete(nb.vocabulary, token)
+		}
+	}
+
+	if nb.classDocs[class] <= 0 {
+		delete(nb.classDocs, class)
+		delete(nb.classTokens, class)
+		delete(nb.tokenCounts, class)
+	}
+}
+
+// Predict retorna as classes ordenadas da mais para a menos provável. Sem nenhum
+// token conhecido não há evidência além da frequência das classes, e o
+// resultado é vazio.
+func (nb *NaiveBayes) Predict(tokens []string) []Prediction {
+	known := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if nb.vocabulary[token] > 0 {
+			known = append(known, token)
+		}
+	}
+	if len(known) == 0 || nb.documents == 0 {
+		return nil
+	}
+
+	// Suavização de Laplace sobre o vocabulário inteiro
+	vocabularySize := float64(len(nb.vocabulary))
+	predictions := make([]Prediction, 0, len(nb.classDocs))
+	maxScore := math.Inf(-1)
+	for class, docs := range nb.classDocs {
+		score := math.Log(float64(docs) / float64(nb.documents))
+		denominator := float64(nb.classTokens[class]) + vocabularySize
+		for _, token := range known {
+			score += math.Log((float64(nb.tokenCounts[class][token]) + 1) / denominator)
+		}
+
+		predictions = append(predictions, Prediction{Class: class, Probability: score})
+		if score > maxScore {
+			maxScore = score
+		}
+	}
+
+	// Converter os logaritmos em probabilidades normalizadas sem estourar a precisão
+	total := 0.0
+	for i := range predictions {
+		predictions[i].Probability = math.Exp(predictions[i].Probability - maxScore)
+		total += predictions[i].Probability
+	}
+	for i := range predictions {
+		predictions[i].Probability /= total
+	}
+
+	sort.Slice(predictions, func(i, j int) bool {
+		if predictions[i].Probability != predictions[j].Probability {
+			return predictions[i].Probability > predictions[j].Probability
+		}
+		return predictions[i].Class < predictions[j].Class
+	})
+
+	return predictions
+}
@@ -0,0 +1,148 @@
+package classifier
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type example struct {
+	class  uint
+	tokens []string
+}
+
+var training = []example{
+	{1, []string{"padaria", "pao"}},
+	{1, []string{"supermercado", "extra"}},
+	{1, []string{"padaria", "doce"}},
+	{2, []string{"uber", "viagem"}},
+	{2, []string{"posto", "gasolina"}},
+	{3, []string{"netflix", "streaming"}},
+	{3, []string{"netflix"}},
+}
+
+func trained(examples []example) *NaiveBayes {
+	nb := NewNaiveBayes()
+	for _, e := range examples {
+		nb.Learn(e.class, e.tokens)
+	}
+	return nb
+}
+
+func TestNaiveBayesPredict(t *testing.T) {
+	nb := trained(training)
+
+	tests := []struct {
+		name      string
+		tokens    []string
+		wantClass uint
+		wantEmpty bool
+	}{
+		{name: "palavra de uma classe", tokens: []string{"padaria"}, wantClass: 1},
+		{name: "palavras conhecidas e desconhecidas", tokens: []string{"uber", "centro"}, wantClass: 2},
+		{name: "classe menos frequente", tokens: []string{"netflix", "assinatura"}, wantClass: 3},
+		{name: "nenhuma palavra conhecida", tokens: []string{"farmacia"}, wantEmpty: true},
+		{name: "sem palavras", tokens: nil, wantEmpty: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			predictions := nb.Predict(tt.tokens)
+			if tt.wantEmpty {
+				if len(predictions) != 0 {
+					t.Fatalf("Predict(%v) = %v, esperava vazio", tt.tokens, predictions)
+				}
+				return
+			}
+
+			if len(predictions) != 3 {
+				t.Fatalf("Predict(%v) retornou %d classes, esperava 3", tt.tokens, len(predictions))
+			}
+			if predictions[0].Class != tt.wantClass {
+				t.Errorf("Predict(%v) sugeriu %d, esperava %d", tt.tokens, predictions[0].Class, tt.wantClass)
+			}
+
+			total := 0.0
+			for i, prediction := range predictions {
+				total += prediction.Probability
+				if i > 0 && prediction.Probability > predictions[i-1].Probability {
+					t.Errorf("previsões fora de ordem: %v", predictions)
+				}
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("probabilidades somam %v, esperava 1", total)
+			}
+		})
+	}
+}
+
+func TestNaiveBayesForget(t *testing.T) {
+	tests := []struct {
+		name    string
+		learned []example
+		forget  []example
+		want    []example
+	}{
+		{
+			name:    "esquecer o último exemplo volta ao estado anterior",
+			learned: training,
+			forget:  []example{training[len(training)-1]},
+			want:    training[:len(training)-1],
+		},
+		{
+			name:    "esquecer exemplo do meio",
+			learned: training,
+			forget:  []example{training[1]},
+			want:    append(append([]example{}, training[:1]...), training[2:]...),
+		},
+		{
+			name:    "mudança de categoria",
+			learned: append(append([]example{}, training...), example{1, []string{"uber", "eats"}}),
+			forget:  []example{{1, []string{"uber", "eats"}}},
+			want:    training,
+		},
+		{
+			name:    "esquecer tudo",
+			learned: training,
+			forget:  training,
+			want:    nil,
+		},
+		{
+			name:    "classe desconhecida é ignorada",
+			learned: training,
+			forget:  []example{{9, []string{"padaria"}}},
+			want:    training,
+		},
+		{
+			name:    "exemplo vazio é ignorado",
+			learned: training,
+			forget:  []example{{1, nil}},
+			want:    training,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nb := trained(tt.learned)
+			for _, e := range tt.forget {
+				nb.Forget(e.class, e.tokens)
+			}
+
+			want := trained(tt.want)
+			if !reflect.DeepEqual(nb, want) {
+				t.Errorf("estado após Forget = %+v, esperava %+v", nb, want)
+			}
+			if nb.Documents() != len(tt.want) {
+				t.Errorf("Documents() = %d, esperava %d", nb.Documents(), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestNaiveBayesLearnIgnoresEmpty(t *testing.T) {
+	nb := NewNaiveBayes()
+	nb.Learn(1, nil)
+	if nb.Documents() != 0 {
+		t.Errorf("Documents() = %d, esperava 0", nb.Documents())
+	}
+}
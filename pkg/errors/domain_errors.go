@@ -51,6 +51,8 @@ func (e DomainError) HTTPStatus() int {
 		return http.StatusBadRequest
 	case "already_exists":
 		return http.StatusConflict
+	case "conflict":
+		return http.StatusConflict
 	case "invalid_credentials":
 		return http.StatusUnauthorized
 	default:
@@ -66,12 +68,24 @@ var (
 	ErrUnauthorized       = NewDomainError("unauthorized", "Não autorizado")
 	ErrForbidden          = NewDomainError("forbidden", "Acesso negado")
 
-	ErrTransactionNotFound = NewDomainError("not_found", "Transação não encontrada")
-	ErrCategoryNotFound    = NewDomainError("not_found", "Categoria não encontrada")
-	ErrGoalNotFound        = NewDomainError("not_found", "Meta não encontrada")
-	ErrSavingGoalNotFound  = NewDomainError("not_found", "Meta de economia não encontrada")
+	ErrTransactionNotFound   = NewDomainError("not_found", "Transação não encontrada")
+	ErrCategoryNotFound      = NewDomainError("not_found", "Categoria não encontrada")
+	ErrGoalNotFound          = NewDomainError("not_found", "Meta não encontrada")
+	ErrSavingGoalNotFound    = NewDomainError("not_found", "Meta de economia não encontrada")
+	ErrAccountNotFound       = NewDomainError("not_found", "Conta não encontrada")
+	ErrInvoiceNotFound       = NewDomainError("not_found", "Fatura não encontrada")
+	ErrTagNotFound           = NewDomainError("not_found", "Tag não encontrada")
+	ErrAttachmentNotFound    = NewDomainError("not_found", "Anexo não encontrado")
+	ErrFileNotFound          = NewDomainError("not_found", "Arquivo não encontrado")
+	ErrImportProfileNotFound = NewDomainError("not_found", "Perfil de importação não encontrado")
+	ErrRuleNotFound          = NewDomainError("not_found", "Regra não encontrada")
+	ErrPayeeNotFound         = NewDomainError("not_found", "Favorecido não encontrado")
+	ErrBudgetNotFound        = NewDomainError("not_found", "Orçamento não encontrado")
+	ErrNotificationNotFound  = NewDomainError("not_found", "Notificação não encontrada")
 
-	ErrInsufficientFunds = NewDomainError("insufficient_funds", "Saldo insuficiente")
-	ErrInvalidAmount     = NewDomainError("validation_error", "Valor inválido")
-	ErrInvalidDate       = NewDomainError("validation_error", "Data inválida")
+	ErrInsufficientFunds  = NewDomainError("insufficient_funds", "Saldo insuficiente")
+	ErrInvalidAmount      = NewDomainError("validation_error", "Valor inválido")
+	ErrInvalidDate        = NewDomainError("validation_error", "Data inválida")
+	ErrInvoiceAlreadyPaid = NewDomainError("validation_error", "Fatura já está paga")
+	ErrAttachmentQuota    = NewDomainError("validation_error", "Cota de armazenamento de anexos excedida")
 )
@@ -0,0 +1,236 @@
+// Package ofx lê extratos bancários no formato OFX/QFX, tanto na versão 1.x
+// (SGML, com tags de valor sem fechamento) quanto na 2.x (XML).
+package ofx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-finance-hub-api/pkg/charset"
+)
+
+// Statement é o extrato de uma conta contido no arquivo
+type Statement struct {
+	BankID    string
+	AccountID string
+	// AccountType segue o ACCTTYPE do OFX (CHECKING, SAVINGS...); vazio em cartões de crédito
+	AccountType string
+	CreditCard  bool
+	Currency    string
+	// Balance é o saldo informado em LEDGERBAL, quando presente
+	Balance      *float64
+	Transactions []Transaction
+}
+
+// Transaction representa um lançamento STMTTRN do extrato
+type Transaction struct {
+	FITID    string
+	Type     string
+	Date     time.Time
+	Amount   float64
+	Name     string
+	Memo     string
+	CheckNum string
+}
+
+var (
+	ErrInvalidFile = errors.New("arquivo OFX inválido")
+
+	transactionPattern = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+	statementPattern   = regexp.MustCompile(`(?is)<(STMTRS|CCSTMTRS)>(.*?)</(?:STMTRS|CCSTMTRS)>`)
+	ledgerPattern      = regexp.MustCompile(`(?is)<LEDGERBAL>(.*?)</LEDGERBAL>`)
+	datePattern        = regexp.MustCompile(`^(\d{8})(\d{6})?(?:\.\d+)?(?:\[([+-]?\d+(?:\.\d+)?)(?::[^\]]*)?\])?$`)
+
+	// amountPattern evita que ParseFloat aceite NaN, Inf ou números hexadecimais
+	amountPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+)
+
+// Parse lê o conteúdo do arquivo e retorna os extratos encontrados
+func Parse(r io.Reader) ([]Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Arquivos de bancos brasileiros costumam vir em Windows-1252 (CHARSET:1252),
+	// mesmo quando o cabeçalho não informa
+	content := charset.ToUTF8(data, charset.Auto)
+	if !strings.Contains(strings.ToUpper(content), "<OFX>") {
+		return nil, ErrInvalidFile
+	}
+
+	var statements []Statement
+	for _, match := range statementPattern.FindAllStringSubmatch(content, -1) {
+		statement, err := parseStatement(match[2])
+		if err != nil {
+			return nil, err
+		}
+		statement.CreditCard = strings.EqualFold(match[1], "CCSTMTRS")
+		statements = append(statements, statement)
+	}
+
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("%w: nenhum extrato encontrado", ErrInvalidFile)
+	}
+
+	return statements, nil
+}
+
+func parseStatement(body string) (Statement, error) {
+	statement := Statement{
+		BankID:      field(body, "BANKID"),
+		AccountID:   field(body, "ACCTID"),
+		AccountType: strings.ToUpper(field(body, "ACCTTYPE")),
+		Currency:    field(body, "CURDEF"),
+	}
+
+	if match := ledgerPattern.FindStringSubmatch(body); match != nil {
+		if balance, err := ParseAmount(field(match[1], "BALAMT")); err == nil {
+			statement.Balance = &balance
+		}
+	}
+
+	for _, match := range transactionPattern.FindAllStringSubmatch(body, -1) {
+		transaction, err := parseTransaction(match[1])
+		if err != nil {
+			return Statement{}, err
+		}
+		statement.Transactions = append(statement.Transactions, transaction)
+	}
+
+	return statement, nil
+}
+
+func parseTransaction(body string) (Transaction, error) {
+	transaction := Transaction{
+		FITID:    field(body, "FITID"),
+		Type:     strings.ToUpper(field(body, "TRNTYPE")),
+		Name:     field(body, "NAME"),
+		Memo:     field(body, "MEMO"),
+		CheckNum: field(body, "CHECKNUM"),
+	}
+
+	if transaction.FITID == "" {
+		return Transaction{}, fmt.Errorf("%w: lançamento sem FITID", ErrInvalidFile)
+	}
+
+	amount, err := ParseAmount(field(body, "TRNAMT"))
+	if err != nil {
+		return Transaction{}, fmt.Errorf("%w: valor do lançamento %s: %v", ErrInvalidFile, transaction.FITID, err)
+	}
+	transaction.Amount = amount
+
+	date, err := ParseDate(field(body, "DTPOSTED"))
+	if err != nil {
+		return Transaction{}, fmt.Errorf("%w: data do lançamento %s: %v", ErrInvalidFile, transaction.FITID, err)
+	}
+	transaction.Date = date
+
+	return transaction, nil
+}
+
+// field retorna o valor da primeira ocorrência da tag. No SGML o valor termina na
+// próxima tag ou quebra de linha; no XML, na tag de fechamento.
+func field(body, tag string) string {
+	idx := indexTag(body, tag)
+	if idx < 0 {
+		return ""
+	}
+
+	value := body[idx+len(tag)+2:]
+	if end := strings.IndexAny(value, "<\r\n"); end >= 0 {
+		value = value[:end]
+	}
+
+	return unescape(strings.TrimSpace(value))
+}
+
+// indexTag retorna a posição de <tag> em body, comparando o nome sem diferenciar
+// maiúsculas apenas nos bytes ASCII, para que a posição valha no texto original
+func indexTag(body, tag string) int {
+	open := "<" + tag + ">"
+	for i := 0; i+len(open) <= len(body); i++ {
+		if body[i] == '<' && asciiEqualFold(body[i:i+len(open)], open) {
+			return i
+		}
+	}
+	return -1
+}
+
+func asciiEqualFold(a, b string) bool {
+	for i := 0; i < len(a); i++ {
+		ca, cb := a[i], b[i]
+		if 'a' <= ca && ca <= 'z' {
+			ca -= 'a' - 'A'
+		}
+		if 'a' <= cb && cb <= 'z' {
+			cb -= 'a' - 'A'
+		}
+		if ca != cb {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDate interpreta datas OFX no formato AAAAMMDD[HHMMSS[.XXX]][[gmt:TZ]]
+func ParseDate(value string) (time.Time, error) {
+	match := datePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return time.Time{}, fmt.Errorf("data inválida: %q", value)
+	}
+
+	layout, raw := "20060102", match[1]
+	if match[2] != "" {
+		layout, raw = "20060102150405", match[1]+match[2]
+	}
+
+	location := time.UTC
+	if match[3] != "" {
+		hours, err := strconv.ParseFloat(match[3], 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("fuso horário inválido: %q", value)
+		}
+		location = time.FixedZone("", int(hours*3600))
+	}
+
+	return time.ParseInLocation(layout, raw, location)
+}
+
+// ParseAmount aceita ponto ou vírgula como separador decimal, como exportado por alguns bancos
+func ParseAmount(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, errors.New("valor vazio")
+	}
+
+	// O separador que aparece por último é o decimal; o outro, de milhar
+	if strings.LastIndex(value, ",") > strings.LastIndex(value, ".") {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.ReplaceAll(value, ",", ".")
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+
+	if !amountPattern.MatchString(value) {
+		return 0, fmt.Errorf("valor inválido: %q", value)
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("valor inválido: %q", value)
+	}
+
+	return amount, nil
+}
+
+var entityReplacer = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&quot;", `"`, "&apos;", "'", "&nbsp;", " ", "&amp;", "&")
+
+func unescape(value string) string {
+	return entityReplacer.Replace(value)
+}
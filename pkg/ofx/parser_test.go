@@ -0,0 +1,217 @@
+package ofx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+CHARSET:1252
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>BRL
+<BANKACCTFROM>
+<BANKID>0341
+<ACCTID>12345-6
+<ACCTTYPE>checking
+</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260105120000[-3:BRT]
+<TRNAMT>-1.234,56
+<FITID>A1
+<MEMO>Padaria S&amp;A
+</STMTTRN>
+<stmttrn>
+<trntype>credit
+<dtposted>20260110
+<trnamt>2500.00
+<fitid>A2
+<name>Salário
+</stmttrn>
+</BANKTRANLIST>
+<LEDGERBAL><BALAMT>1265,44<DTASOF>20260131</LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+func TestParseSGML(t *testing.T) {
+	statements, err := Parse(strings.NewReader(sgmlStatement))
+	if err != nil {
+		t.Fatalf("Parse retornou erro: %v", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("esperava 1 extrato, obteve %d", len(statements))
+	}
+
+	statement := statements[0]
+	if statement.BankID != "0341" || statement.AccountID != "12345-6" || statement.AccountType != "CHECKING" || statement.CreditCard {
+		t.Errorf("dados da conta inesperados: %+v", statement)
+	}
+	if statement.Balance == nil || *statement.Balance != 1265.44 {
+		t.Errorf("saldo inesperado: %v", statement.Balance)
+	}
+	if len(statement.Transactions) != 2 {
+		t.Fatalf("esperava 2 lançamentos, obteve %d", len(statement.Transactions))
+	}
+
+	first := statement.Transactions[0]
+	if first.Amount != -1234.56 || first.Memo != "Padaria S&A" || first.Type != "DEBIT" {
+		t.Errorf("primeiro lançamento inesperado: %+v", first)
+	}
+	if want := time.Date(2026, 1, 5, 15, 0, 0, 0, time.UTC); !first.Date.Equal(want) {
+		t.Errorf("data do primeiro lançamento = %v, esperava %v", first.Date, want)
+	}
+
+	second := statement.Transactions[1]
+	if second.FITID != "A2" || second.Type != "CREDIT" || second.Amount != 2500 || second.Name != "Salário" {
+		t.Errorf("segundo lançamento inesperado: %+v", second)
+	}
+}
+
+func TestParseCreditCardXML(t *testing.T) {
+	content := `<?xml version="1.0"?><OFX><CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>` +
+		`<CURDEF>BRL</CURDEF><CCACCTFROM><ACCTID>4111</ACCTID></CCACCTFROM>` +
+		`<BANKTRANLIST><STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20260201</DTPOSTED>` +
+		`<TRNAMT>-99.90</TRNAMT><FITID>C1</FITID><NAME>Loja</NAME></STMTTRN></BANKTRANLIST>` +
+		`</CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>`
+
+	statements, err := Parse(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Parse retornou erro: %v", err)
+	}
+	if len(statements) != 1 || !statements[0].CreditCard || statements[0].AccountID != "4111" {
+		t.Fatalf("extrato inesperado: %+v", statements)
+	}
+	if transactions := statements[0].Transactions; len(transactions) != 1 || transactions[0].Amount != -99.90 || transactions[0].Name != "Loja" {
+		t.Errorf("lançamentos inesperados: %+v", transactions)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "sem OFX", content: "data;valor\n01/01/2026;10,00"},
+		{name: "sem extrato", content: "<OFX><SIGNONMSGSRSV1></SIGNONMSGSRSV1></OFX>"},
+		{name: "lançamento sem FITID", content: "<OFX><STMTRS><STMTTRN><TRNAMT>1<DTPOSTED>20260101</STMTTRN></STMTRS></OFX>"},
+		{name: "valor NaN", content: "<OFX><STMTRS><STMTTRN><FITID>1<TRNAMT>NaN<DTPOSTED>20260101</STMTTRN></STMTRS></OFX>"},
+		{name: "data inválida", content: "<OFX><STMTRS><STMTTRN><FITID>1<TRNAMT>1<DTPOSTED>2026-01-01</STMTTRN></STMTRS></OFX>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.content)); !errors.Is(err, ErrInvalidFile) {
+				t.Errorf("Parse retornou %v, esperava ErrInvalidFile", err)
+			}
+		})
+	}
+}
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		tag  string
+		want string
+	}{
+		{name: "SGML", body: "<FITID>123\n<MEMO>x", tag: "FITID", want: "123"},
+		{name: "XML", body: "<FITID>123</FITID>", tag: "FITID", want: "123"},
+		{name: "minúsculas", body: "<fitid> 123 \r\n", tag: "FITID", want: "123"},
+		{name: "entidades", body: "<MEMO>A &lt;B&gt; &amp; C", tag: "MEMO", want: "A <B> & C"},
+		{name: "ausente", body: "<MEMO>x", tag: "FITID", want: ""},
+		// Caracteres cuja forma maiúscula muda de tamanho em bytes não podem deslocar a posição
+		{name: "s longo antes da tag", body: "<MEMO>ſſſſ\n<FITID>9", tag: "FITID", want: "9"},
+		{name: "a invertido antes da tag", body: "<MEMO>ɐɐɐɐɐɐ\n<FITID>9", tag: "FITID", want: "9"},
+		{name: "tag no fim", body: "texto ɐ<FITID", tag: "FITID", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := field(tt.body, tt.tag); got != tt.want {
+				t.Errorf("field(%q, %q) = %q, esperava %q", tt.body, tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    float64
+		wantErr bool
+	}{
+		{value: "-10.50", want: -10.5},
+		{value: "+10", want: 10},
+		{value: "1,234.56", want: 1234.56},
+		{value: "1.234,56", want: 1234.56},
+		{value: "-0,99", want: -0.99},
+		{value: ".5", want: 0.5},
+		{value: "", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "NaN", wantErr: true},
+		{value: "Inf", wantErr: true},
+		{value: "-Infinity", wantErr: true},
+		{value: "0x1p4", wantErr: true},
+		{value: "1e3", wantErr: true},
+		{value: strings.Repeat("9", 400), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAmount(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAmount(%q) = %v, esperava erro", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) retornou erro: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAmount(%q) = %v, esperava %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "20260105", want: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{value: "20260105143000", want: time.Date(2026, 1, 5, 14, 30, 0, 0, time.UTC)},
+		{value: "20260105143000.123", want: time.Date(2026, 1, 5, 14, 30, 0, 0, time.UTC)},
+		{value: "20260105120000[-3:BRT]", want: time.Date(2026, 1, 5, 15, 0, 0, 0, time.UTC)},
+		{value: "20260105000000[+5.5]", want: time.Date(2026, 1, 4, 18, 30, 0, 0, time.UTC)},
+		{value: "2026-01-05", wantErr: true},
+		{value: "20261305", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDate(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDate(%q) = %v, esperava erro", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) retornou erro: %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, esperava %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package ofx
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"my-finance-hub-api/pkg/charset"
+)
+
+// Cabeçalho do OFX 1.02 (SGML), o formato aceito pela maioria dos programas
+// financeiros e de contabilidade no Brasil
+const sgmlHeader = "OFXHEADER:100\r\nDATA:OFXSGML\r\nVERSION:102\r\nSECURITY:NONE\r\nENCODING:USASCII\r\n" +
+	"CHARSET:1252\r\nCOMPRESSION:NONE\r\nOLDFILEUID:NONE\r\nNEWFILEUID:NONE\r\n\r\n"
+
+// Limites de tamanho dos campos definidos pela especificação OFX 1.02
+const (
+	maxNameLength = 32
+	maxMemoLength = 255
+)
+
+var escapeReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Write grava os extratos em OFX 1.02 codificado em Windows-1252. Extratos de
+// cartão de crédito vão em CREDITCARDMSGSRSV1 e os demais em BANKMSGSRSV1.
+func Write(w io.Writer, statements []Statement, generatedAt time.Time) error {
+	out := &sgmlWriter{w: bufio.NewWriter(w)}
+
+	out.raw(sgmlHeader)
+	out.open("OFX")
+	out.open("SIGNONMSGSRSV1")
+	out.open("SONRS")
+	writeStatus(out)
+	out.element("DTSERVER", FormatDate(generatedAt))
+	out.element("LANGUAGE", "POR")
+	out.close("SONRS")
+	out.close("SIGNONMSGSRSV1")
+
+	var bank, cards []Statement
+	for _, statement := range statements {
+		if statement.CreditCard {
+			cards = append(cards, statement)
+		} else {
+			bank = append(bank, statement)
+		}
+	}
+
+	if len(bank) > 0 {
+		out.open("BANKMSGSRSV1")
+		for i, statement := range bank {
+			writeStatement(out, statement, i+1, generatedAt)
+		}
+		out.close("BANKMSGSRSV1")
+	}
+
+	if len(cards) > 0 {
+		out.open("CREDITCARDMSGSRSV1")
+		for i, statement := range cards {
+			writeStatement(out, statement, i+1, generatedAt)
+		}
+		out.close("CREDITCARDMSGSRSV1")
+	}
+
+	out.close("OFX")
+
+	if out.err != nil {
+		return out.err
+	}
+	return out.w.Flush()
+}
+
+func writeStatement(out *sgmlWriter, statement Statement, trnUID int, generatedAt time.Time) {
+	wrapper, response, accountFrom := "STMTTRNRS", "STMTRS", "BANKACCTFROM"
+	if statement.CreditCard {
+		wrapper, response, accountFrom = "CCSTMTTRNRS", "CCSTMTRS", "CCACCTFROM"
+	}
+
+	currency := statement.Currency
+	if currency == "" {
+		currency = "BRL"
+	}
+
+	out.open(wrapper)
+	out.element("TRNUID", fmt.Sprint(trnUID))
+	writeStatus(out)
+	out.open(response)
+	out.element("CURDEF", currency)
+
+	out.open(accountFrom)
+	if !statement.CreditCard {
+		out.element("BANKID", statement.BankID)
+	}
+	out.element("ACCTID", statement.AccountID)
+	if !statement.CreditCard {
+		accountType := statement.AccountType
+		if accountType == "" {
+			accountType = "CHECKING"
+		}
+		out.element("ACCTTYPE", accountType)
+	}
+	out.close(accountFrom)
+
+	start, end := generatedAt, generatedAt
+	for i, transaction := range statement.Transactions {
+		if i == 0 || transaction.Date.Before(start) {
+			start = transaction.Date
+		}
+		if i == 0 || transaction.Date.After(end) {
+			end = transaction.Date
+		}
+	}
+
+	out.open("BANKTRANLIST")
+	out.element("DTSTART", FormatDate(start))
+	out.element("DTEND", FormatDate(end))
+	for _, transaction := range statement.Transactions {
+		writeTransaction(out, transaction)
+	}
+	out.close("BANKTRANLIST")
+
+	if statement.Balance != nil {
+		out.open("LEDGERBAL")
+		out.element("BALAMT", FormatAmount(*statement.Balance))
+		out.element("DTASOF", FormatDate(generatedAt))
+		out.close("LEDGERBAL")
+	}
+
+	out.close(response)
+	out.close(wrapper)
+}
+
+func writeTransaction(out *sgmlWriter, transaction Transaction) {
+	transactionType := transaction.Type
+	if transactionType == "" {
+		transactionType = "CREDIT"
+		if transaction.Amount < 0 {
+			transactionType = "DEBIT"
+		}
+	}
+
+	out.open("STMTTRN")
+	out.element("TRNTYPE", transactionType)
+	out.element("DTPOSTED", FormatDate(transaction.Date))
+	out.element("TRNAMT", FormatAmount(transaction.Amount))
+	out.element("FITID", transaction.FITID)
+	if transaction.CheckNum != "" {
+		out.element("CHECKNUM", transaction.CheckNum)
+	}
+	out.element("NAME", limit(transaction.Name, maxNameLength))
+	if transaction.Memo != "" {
+		out.element("MEMO", limit(transaction.Memo, maxMemoLength))
+	}
+	out.close("STMTTRN")
+}
+
+func writeStatus(out *sgmlWriter) {
+	out.open("STATUS")
+	out.element("CODE", "0")
+	out.element("SEVERITY", "INFO")
+	out.close("STATUS")
+}
+
+// FormatDate formata a data no padrão OFX (AAAAMMDDHHMMSS)
+func FormatDate(date time.Time) string {
+	return date.Format("20060102150405")
+}
+
+// FormatAmount formata o valor com ponto decimal e duas casas
+func FormatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
+
+func limit(value string, size int) string {
+	if runes := []rune(value); len(runes) > size {
+		return string(runes[:size])
+	}
+	return value
+}
+
+// sgmlWriter escreve os elementos guardando o primeiro erro. No SGML apenas os
+// agregados têm tag de fechamento; os elementos terminam na quebra de linha.
+type sgmlWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (s *sgmlWriter) raw(value string) {
+	if s.err == nil {
+		_, s.err = s.w.Write(charset.FromUTF8(value, charset.Latin1))
+	}
+}
+
+func (s *sgmlWriter) open(tag string) {
+	s.raw("<" + tag + ">\r\n")
+}
+
+func (s *sgmlWriter) close(tag string) {
+	s.raw("</" + tag + ">\r\n")
+}
+
+func (s *sgmlWriter) element(tag, value string) {
+	value = strings.Join(strings.Fields(value), " ")
+	s.raw("<" + tag + ">" + escapeReplacer.Replace(value) + "\r\n")
+}
@@ -0,0 +1,97 @@
+package ofx
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteRoundTrip(t *testing.T) {
+	balance := 1265.44
+	statements := []Statement{
+		{
+			BankID:      "0341",
+			AccountID:   "12345-6",
+			AccountType: "SAVINGS",
+			Currency:    "BRL",
+			Balance:     &balance,
+			Transactions: []Transaction{
+				{FITID: "1", Type: "DEBIT", Date: time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC), Amount: -1234.56, Name: "Padaria S&A <Centro>", Memo: "Pão e café"},
+				{FITID: "2", Type: "CREDIT", Date: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), Amount: 2500, Name: "Salário", CheckNum: "001"},
+			},
+		},
+		{
+			AccountID:  "4111",
+			CreditCard: true,
+			Currency:   "BRL",
+			Transactions: []Transaction{
+				{FITID: "3", Type: "DEBIT", Date: time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC), Amount: -89.9, Name: "Streaming"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, statements, time.Date(2026, 1, 31, 18, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Write retornou erro: %v", err)
+	}
+
+	// O arquivo é gravado em Windows-1252, com os caracteres especiais escapados
+	content := buf.String()
+	if !strings.Contains(content, "<NAME>Padaria S&amp;A &lt;Centro&gt;\r\n") {
+		t.Errorf("descrição não escapada: %q", content)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("<MEMO>P\xe3o e caf\xe9\r\n")) {
+		t.Errorf("memo não codificado em Windows-1252: %q", content)
+	}
+
+	parsed, err := Parse(&buf)
+	if err != nil {
+		t.Fatalf("Parse retornou erro: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, statements) {
+		t.Errorf("Parse(Write) = %+v, esperava %+v", parsed, statements)
+	}
+}
+
+func TestWriteDefaults(t *testing.T) {
+	statements := []Statement{{
+		BankID:    "001",
+		AccountID: "99",
+		Transactions: []Transaction{
+			{FITID: "1", Date: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), Amount: -10, Name: strings.Repeat("a", 40) + "  com   espaços"},
+			{FITID: "2", Date: time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC), Amount: 10, Name: "Estorno", Memo: strings.Repeat("m", 300)},
+		},
+	}}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, statements, time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Write retornou erro: %v", err)
+	}
+
+	parsed, err := Parse(&buf)
+	if err != nil {
+		t.Fatalf("Parse retornou erro: %v", err)
+	}
+	statement := parsed[0]
+	if statement.Currency != "BRL" || statement.AccountType != "CHECKING" || statement.Balance != nil {
+		t.Errorf("padrões do extrato inesperados: %+v", statement)
+	}
+
+	tests := []struct {
+		transactionType string
+		name            string
+		memoLength      int
+	}{
+		{transactionType: "DEBIT", name: strings.Repeat("a", maxNameLength)},
+		{transactionType: "CREDIT", name: "Estorno", memoLength: maxMemoLength},
+	}
+	for i, tt := range tests {
+		transaction := statement.Transactions[i]
+		if transaction.Type != tt.transactionType || transaction.Name != tt.name || len(transaction.Memo) != tt.memoLength {
+			t.Errorf("lançamento %d = %+v, esperava tipo %s, nome %q e memo de %d caracteres",
+				i, transaction, tt.transactionType, tt.name, tt.memoLength)
+		}
+	}
+}
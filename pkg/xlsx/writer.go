@@ -0,0 +1,288 @@
+// Package xlsx gera planilhas no formato Office Open XML (.xlsx) de forma
+// sequencial, sem manter as linhas em memória.
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Estilos definidos em styles.xml, na ordem de cellXfs
+const (
+	styleDefault = iota
+	styleDate
+	styleMoney
+	styleBold
+)
+
+// Money é um valor numérico exibido com separador de milhar e duas casas decimais
+type Money float64
+
+// Bold é um texto exibido em negrito
+type Bold string
+
+// excelEpoch é a data base dos números seriais de data do Excel
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+var ErrClosed = errors.New("xlsx: planilha já finalizada")
+
+// Writer grava as abas em sequência: cada AddSheet finaliza a aba anterior e
+// Close grava a estrutura do arquivo.
+type Writer struct {
+	zip    *zip.Writer
+	sheets []string
+	sheet  io.Writer
+	row    int
+	closed bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{zip: zip.NewWriter(w)}
+}
+
+// AddSheet inicia uma nova aba. widths define a largura das primeiras colunas.
+func (w *Writer) AddSheet(name string, widths ...float64) error {
+	if w.closed {
+		return ErrClosed
+	}
+	if err := w.endSheet(); err != nil {
+		return err
+	}
+
+	sheet, err := w.zip.Create(fmt.Sprintf("xl/worksheets/sheet%d.xml", len(w.sheets)+1))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if len(widths) > 0 {
+		buf.WriteString("<cols>")
+		for i, width := range widths {
+			fmt.Fprintf(&buf, `<col min="%d" max="%d" width="%g" customWidth="1"/>`, i+1, i+1, width)
+		}
+		buf.WriteString("</cols>")
+	}
+	buf.WriteString("<sheetData>")
+	if _, err := sheet.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	w.sheets = append(w.sheets, name)
+	w.sheet = sheet
+	w.row = 0
+	return nil
+}
+
+// WriteRow grava uma linha na aba atual. São aceitos string, Bold, números,
+// Money, bool, time.Time (como data) e nil (célula vazia).
+func (w *Writer) WriteRow(values ...interface{}) error {
+	if w.closed {
+		return ErrClosed
+	}
+	if w.sheet == nil {
+		return errors.New("xlsx: nenhuma aba iniciada")
+	}
+
+	w.row++
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<row r="%d">`, w.row)
+	for i, value := range values {
+		if err := writeCell(&buf, cellRef(i, w.row), value); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("</row>")
+
+	_, err := w.sheet.Write(buf.Bytes())
+	return err
+}
+
+// Close finaliza a última aba e grava workbook, estilos e relacionamentos
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	if len(w.sheets) == 0 {
+		if err := w.AddSheet("Planilha1"); err != nil {
+			return err
+		}
+	}
+	if err := w.endSheet(); err != nil {
+		return err
+	}
+	w.closed = true
+
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", w.contentTypes()},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", w.workbook()},
+		{"xl/_rels/workbook.xml.rels", w.workbookRels()},
+		{"xl/styles.xml", styles},
+	}
+
+	for _, file := range files {
+		f, err := w.zip.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, file.content); err != nil {
+			return err
+		}
+	}
+
+	return w.zip.Close()
+}
+
+func (w *Writer) endSheet() error {
+	if w.sheet == nil {
+		return nil
+	}
+	_, err := io.WriteString(w.sheet, "</sheetData></worksheet>")
+	w.sheet = nil
+	return err
+}
+
+func writeCell(buf *bytes.Buffer, ref string, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		writeString(buf, ref, v, styleDefault)
+	case Bold:
+		writeString(buf, ref, string(v), styleBold)
+	case Money:
+		writeNumber(buf, ref, strconv.FormatFloat(float64(v), 'f', 2, 64), styleMoney)
+	case float64:
+		writeNumber(buf, ref, strconv.FormatFloat(v, 'f', -1, 64), styleDefault)
+	case int:
+		writeNumber(buf, ref, strconv.Itoa(v), styleDefault)
+	case uint:
+		writeNumber(buf, ref, strconv.FormatUint(uint64(v), 10), styleDefault)
+	case bool:
+		flag := "0"
+		if v {
+			flag = "1"
+		}
+		fmt.Fprintf(buf, `<c r="%s" t="b"><v>%s</v></c>`, ref, flag)
+	case time.Time:
+		writeNumber(buf, ref, strconv.FormatFloat(serialDate(v), 'f', -1, 64), styleDate)
+	default:
+		return fmt.Errorf("xlsx: tipo de célula não suportado: %T", value)
+	}
+	return nil
+}
+
+func writeString(buf *bytes.Buffer, ref, value string, style int) {
+	fmt.Fprintf(buf, `<c r="%s" t="inlineStr" s="%d"><is><t xml:space="preserve">`, ref, style)
+	xml.EscapeText(buf, []byte(value))
+	buf.WriteString("</t></is></c>")
+}
+
+func writeNumber(buf *bytes.Buffer, ref, value string, style int) {
+	fmt.Fprintf(buf, `<c r="%s" s="%d"><v>%s</v></c>`, ref, style, value)
+}
+
+// serialDate converte a data (sem hora) para o número serial usado pelo Excel
+func serialDate(date time.Time) float64 {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return day.Sub(excelEpoch).Hours() / 24
+}
+
+// cellRef monta a referência da célula (ex.: coluna 0, linha 1 = "A1")
+func cellRef(column, row int) string {
+	name := ""
+	for column >= 0 {
+		name = string(rune('A'+column%26)) + name
+		column = column/26 - 1
+	}
+	return name + strconv.Itoa(row)
+}
+
+func (w *Writer) contentTypes() string {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	buf.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	buf.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	buf.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	buf.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range w.sheets {
+		fmt.Fprintf(&buf, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	buf.WriteString(`</Types>`)
+	return buf.String()
+}
+
+func (w *Writer) workbook() string {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, name := range w.sheets {
+		buf.WriteString(`<sheet name="`)
+		xml.EscapeText(&buf, []byte(sheetName(name)))
+		fmt.Fprintf(&buf, `" sheetId="%d" r:id="rId%d"/>`, i+1, i+1)
+	}
+	buf.WriteString(`</sheets></workbook>`)
+	return buf.String()
+}
+
+func (w *Writer) workbookRels() string {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range w.sheets {
+		fmt.Fprintf(&buf, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	fmt.Fprintf(&buf, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(w.sheets)+1)
+	buf.WriteString(`</Relationships>`)
+	return buf.String()
+}
+
+// sheetName remove caracteres proibidos e respeita o limite de 31 caracteres do Excel
+func sheetName(name string) string {
+	runes := make([]rune, 0, len(name))
+	for _, r := range name {
+		switch r {
+		case '\\', '/', '?', '*', '[', ']', ':':
+			continue
+		}
+		runes = append(runes, r)
+	}
+	if len(runes) > 31 {
+		runes = runes[:31]
+	}
+	if len(runes) == 0 {
+		return "Planilha"
+	}
+	return string(runes)
+}
+
+const rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// styles usa formatos numéricos embutidos: 14 (data curta do idioma) e 4 (#,##0.00)
+const styles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="14" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
@@ -0,0 +1,171 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+type sheetXML struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R      string `xml:"r,attr"`
+			T      string `xml:"t,attr"`
+			S      string `xml:"s,attr"`
+			V      string `xml:"v"`
+			Inline string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// readFiles abre a planilha gerada e retorna o conteúdo de cada arquivo do pacote
+func readFiles(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader retornou erro: %v", err)
+	}
+
+	files := make(map[string][]byte, len(reader.File))
+	for _, file := range reader.File {
+		f, err := file.Open()
+		if err != nil {
+			t.Fatalf("abrir %s retornou erro: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("ler %s retornou erro: %v", file.Name, err)
+		}
+		files[file.Name] = content
+	}
+	return files
+}
+
+func TestWriterCells(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if err := w.AddSheet("Transações", 12, 40); err != nil {
+		t.Fatalf("AddSheet retornou erro: %v", err)
+	}
+	rows := [][]interface{}{
+		{Bold("Data"), Bold("Descrição"), Bold("Valor")},
+		{time.Date(2026, 1, 5, 15, 30, 0, 0, time.UTC), `Padaria "S&A" <Centro>`, Money(-1234.5), 3, uint(7), 0.125, true, nil, "fim"},
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row...); err != nil {
+			t.Fatalf("WriteRow retornou erro: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close retornou erro: %v", err)
+	}
+
+	files := readFiles(t, buf.Bytes())
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml"} {
+		content, ok := files[name]
+		if !ok {
+			t.Fatalf("arquivo %s ausente", name)
+		}
+		// Todo arquivo do pacote precisa ser XML válido
+		if err := xml.Unmarshal(content, new(struct{})); err != nil {
+			t.Errorf("%s não é XML válido: %v", name, err)
+		}
+	}
+
+	var sheet sheetXML
+	if err := xml.Unmarshal(files["xl/worksheets/sheet1.xml"], &sheet); err != nil {
+		t.Fatalf("xml.Unmarshal retornou erro: %v", err)
+	}
+	if len(sheet.Rows) != 2 || sheet.Rows[0].R != 1 || sheet.Rows[1].R != 2 {
+		t.Fatalf("linhas inesperadas: %+v", sheet.Rows)
+	}
+
+	// [referência, tipo, estilo, valor]; textos são gravados inline, sem tabela de strings compartilhadas
+	want := [][4]string{
+		{"A2", "", "1", "46027"},
+		{"B2", "inlineStr", "0", `Padaria "S&A" <Centro>`},
+		{"C2", "", "2", "-1234.50"},
+		{"D2", "", "0", "3"},
+		{"E2", "", "0", "7"},
+		{"F2", "", "0", "0.125"},
+		{"G2", "b", "", "1"},
+		{"I2", "inlineStr", "0", "fim"},
+	}
+	cells := sheet.Rows[1].Cells
+	if len(cells) != len(want) {
+		t.Fatalf("%d células, esperava %d: %+v", len(cells), len(want), cells)
+	}
+	for i, cell := range cells {
+		value := cell.V
+		if cell.T == "inlineStr" {
+			value = cell.Inline
+		}
+		if got := [4]string{cell.R, cell.T, cell.S, value}; got != want[i] {
+			t.Errorf("célula %d = %v, esperava %v", i, got, want[i])
+		}
+	}
+	if header := sheet.Rows[0].Cells[1]; header.S != "3" || header.Inline != "Descrição" {
+		t.Errorf("cabeçalho = %+v, esperava texto em negrito", header)
+	}
+
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		t.Errorf("planilha não deveria ter tabela de strings compartilhadas")
+	}
+	if !strings.Contains(string(files["xl/worksheets/sheet1.xml"]), "&lt;Centro&gt;") {
+		t.Errorf("texto não escapado na aba")
+	}
+}
+
+func TestWriterSheets(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, name := range []string{"Resumo: 2026/01", "[]", strings.Repeat("x", 40)} {
+		if err := w.AddSheet(name); err != nil {
+			t.Fatalf("AddSheet retornou erro: %v", err)
+		}
+	}
+	if err := w.WriteRow(struct{}{}); err == nil {
+		t.Errorf("WriteRow com tipo não suportado deveria falhar")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close retornou erro: %v", err)
+	}
+	if err := w.WriteRow("depois"); !errors.Is(err, ErrClosed) {
+		t.Errorf("WriteRow após Close = %v, esperava ErrClosed", err)
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := xml.Unmarshal(readFiles(t, buf.Bytes())["xl/workbook.xml"], &workbook); err != nil {
+		t.Fatalf("xml.Unmarshal retornou erro: %v", err)
+	}
+
+	want := []string{"Resumo 202601", "Planilha", strings.Repeat("x", 31)}
+	if len(workbook.Sheets) != len(want) {
+		t.Fatalf("abas = %+v, esperava %v", workbook.Sheets, want)
+	}
+	for i, sheet := range workbook.Sheets {
+		if sheet.Name != want[i] {
+			t.Errorf("aba %d = %q, esperava %q", i, sheet.Name, want[i])
+		}
+	}
+}
+
+func TestCellRef(t *testing.T) {
+	for column, want := range map[int]string{0: "A1", 25: "Z1", 26: "AA1", 27: "AB1", 701: "ZZ1", 702: "AAA1"} {
+		if got := cellRef(column, 1); got != want {
+			t.Errorf("cellRef(%d, 1) = %s, esperava %s", column, got, want)
+		}
+	}
+}